@@ -0,0 +1,189 @@
+package belvedere
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// GroupCount is the number of rows sharing one value of the grouped column.
+// Key is normalized as in SelectMaps: int64 or uint64 for integer columns,
+// float64, time.Time, string for text and decimals, or nil for NULL.
+type GroupCount struct {
+	Key   interface{}
+	Count int
+}
+
+// aggregate runs `SELECT fn(column) FROM tableName WHERE ...` and scans the
+// single result into result. Options other than With, where conditions and
+// joins are rejected rather than silently ignored.
+func (b *Belvedere) aggregate(ctx context.Context, fn, column, tableName string, som SelectOptionMap, result interface{}) error {
+	if err := som.only(selectOptionTypeWith, selectOptionTypeWhere, selectOptionTypeJoin); err != nil {
+		return err
+	}
+
+	if column != "*" || fn != "COUNT" {
+		if err := validateIdentifier(column); err != nil {
+			return err
+		}
+		column = b.dialect.Quote(column)
+	}
+
+	selectList := fmt.Sprintf("%s(%s) AS %s", fn, column, b.dialect.Quote("result"))
+	q, params, err := b.buildQuery(selectList, b.from(tableName, ""), som)
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		if err = rows.Scan(result); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (b *Belvedere) count(ctx context.Context, fn string, tableName string, som SelectOptionMap) (int, error) {
+	var cnt int
+	if err := b.aggregate(ctx, "COUNT", fn, tableName, som, &cnt); err != nil {
+		return 0, err
+	}
+
+	return cnt, nil
+}
+
+// Sum scans the sum of column into result, which is NULL when no rows match.
+// Databases return the sum of an integer or DECIMAL column as DECIMAL, so
+// result is a pointer chosen by the caller, e.g. *sql.NullInt64 for integer
+// sums, or *sql.NullString to keep decimals exact.
+func (b *Belvedere) Sum(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error {
+	tableInfo := newTableInfo(dst)
+	return b.aggregate(ctx, "SUM", column, tableInfo.Name, b.selectOptionMap(options...), result)
+}
+
+// Avg returns the average of column, which is NULL when no rows match.
+func (b *Belvedere) Avg(ctx context.Context, column string, dst interface{}, options ...NewSelectOption) (sql.NullFloat64, error) {
+	var avg sql.NullFloat64
+	tableInfo := newTableInfo(dst)
+	err := b.aggregate(ctx, "AVG", column, tableInfo.Name, b.selectOptionMap(options...), &avg)
+
+	return avg, err
+}
+
+// Min scans the smallest value of column into result. Since the type of the
+// value depends on the column, result is a pointer chosen by the caller,
+// e.g. *sql.NullInt64 or *sql.NullString to tell an empty set apart.
+func (b *Belvedere) Min(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error {
+	tableInfo := newTableInfo(dst)
+	return b.aggregate(ctx, "MIN", column, tableInfo.Name, b.selectOptionMap(options...), result)
+}
+
+// Max scans the largest value of column into result. See Min.
+func (b *Belvedere) Max(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error {
+	tableInfo := newTableInfo(dst)
+	return b.aggregate(ctx, "MAX", column, tableInfo.Name, b.selectOptionMap(options...), result)
+}
+
+// CountBy counts the rows for every value of column. Having, Order, Limit and
+// Offset apply to the groups.
+func (b *Belvedere) CountBy(ctx context.Context, column string, dst interface{}, options ...NewSelectOption) ([]GroupCount, error) {
+	if err := validateIdentifier(column); err != nil {
+		return nil, err
+	}
+
+	tableInfo := newTableInfo(dst)
+	som := b.selectOptionMap(append(options, GroupBy(column))...)
+	err := som.only(
+		selectOptionTypeWith,
+		selectOptionTypeJoin,
+		selectOptionTypeWhere,
+		selectOptionTypeGroupBy,
+		selectOptionTypeHaving,
+		selectOptionTypeOrder,
+		selectOptionTypeLimit,
+		selectOptionTypeOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(som.GroupBy()) > 1 {
+		return nil, ErrUnsupportedOption
+	}
+
+	selectList := fmt.Sprintf("%s, COUNT(*) AS %s", b.dialect.Quote(column), b.dialect.Quote("cnt"))
+	q, params, err := b.buildQuery(selectList, b.from(tableInfo.Name, ""), som)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepared so that the key has the same type with or without params.
+	stmt, err := b.conn(ctx).PrepareContext(ctx, b.dialect.Rebind(q))
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := []GroupCount{}
+	for rows.Next() {
+		var gc GroupCount
+		if err = rows.Scan(&gc.Key, &gc.Count); err != nil {
+			return nil, err
+		}
+
+		gc.Key = normalizeValue(columnTypes[0].DatabaseTypeName(), columnTypes[0].ScanType(), gc.Key)
+		counts = append(counts, gc)
+	}
+
+	return counts, rows.Err()
+}
+
+// Exists reports whether any row matches the where options. Unlike Count it
+// stops at the first matching row.
+func (b *Belvedere) Exists(ctx context.Context, dst interface{}, options ...NewSelectOption) (bool, error) {
+	tableInfo := newTableInfo(dst)
+	som := b.selectOptionMap(options...)
+	if err := som.only(selectOptionTypeWhere, selectOptionTypeJoin); err != nil {
+		return false, err
+	}
+
+	subQuery, params, err := b.buildQuery("1", b.from(tableInfo.Name, ""), som)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := b.query(ctx, fmt.Sprintf("SELECT EXISTS(%s)", subQuery), params)
+	if err != nil {
+		return false, err
+	}
+
+	defer rows.Close()
+
+	var exists bool
+	for rows.Next() {
+		if err = rows.Scan(&exists); err != nil {
+			return false, err
+		}
+	}
+
+	return exists, rows.Err()
+}
@@ -0,0 +1,46 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupCount_Key(t *testing.T) {
+	// The text protocol returns []byte and the binary protocol returns
+	// int64 for the same integer column.
+	tests := []struct {
+		name         string
+		databaseType string
+		scanType     reflect.Type
+		in           interface{}
+	}{
+		{
+			name:         "text protocol",
+			databaseType: "INT",
+			in:           []byte("20"),
+		},
+		{
+			name:         "binary protocol",
+			databaseType: "INT",
+			in:           int64(20),
+		},
+		{
+			name:     "text protocol without database type",
+			scanType: reflect.TypeOf(int64(0)),
+			in:       []byte("20"),
+		},
+		{
+			name: "binary protocol without column types",
+			in:   int64(20),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := normalizeValue(tt.databaseType, tt.scanType, tt.in)
+			if !reflect.DeepEqual(key, int64(20)) {
+				t.Errorf("normalizeValue() result: %#v expected value: %#v", key, int64(20))
+			}
+		})
+	}
+}
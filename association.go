@@ -0,0 +1,99 @@
+package belvedere
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// manyToMany returns the many_to_many relation name of owner, the primary key
+// value of owner and the primary key values of targets.
+func manyToMany(owner interface{}, name string, targets []interface{}) (*relation, interface{}, []interface{}, error) {
+	ti := newTableInfo(owner)
+	r, err := parseRelation(ti.ColumnInfo, name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if r.kind != relationManyToMany {
+		return nil, nil, nil, fmt.Errorf("belvedere: %s is not a %s relation", name, relationManyToMany)
+	}
+	if ti.Pk.Name == "" {
+		return nil, nil, nil, ErrNoPrimaryKey
+	}
+
+	ownerKey, err := ti.PkValue()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	targetKeys := make([]interface{}, len(targets))
+	for i, target := range targets {
+		targetInfo := newTableInfo(target)
+		if targetInfo.ColumnInfo != r.target {
+			return nil, nil, nil, fmt.Errorf("belvedere: cannot associate %v with %s", targetInfo.ColumnInfo, name)
+		}
+		if targetInfo.Pk.Name == "" {
+			return nil, nil, nil, ErrNoPrimaryKey
+		}
+		if targetKeys[i], err = targetInfo.PkValue(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return r, ownerKey, targetKeys, nil
+}
+
+// Associate inserts a row into the join table of the many_to_many relation
+// name of owner for every target. The rows are inserted in the transaction of
+// ctx, or in a new one outside of a transaction.
+func (b *Belvedere) Associate(ctx context.Context, owner interface{}, name string, targets ...interface{}) error {
+	r, ownerKey, targetKeys, err := manyToMany(owner, name, targets)
+	if err != nil {
+		return err
+	}
+	if len(targetKeys) == 0 {
+		return nil
+	}
+
+	columnNames := strings.Join(quoteAll(b.dialect, []string{r.fk, r.ref}), ",")
+	q := fmt.Sprintf("INSERT INTO %s(%s) VALUES(?,?)", b.dialect.Quote(r.join), columnNames)
+
+	return b.Transaction(ctx, func(ctx context.Context) error {
+		stmt, err := b.conn(ctx).PrepareContext(ctx, b.dialect.Rebind(q))
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, key := range targetKeys {
+			if _, err := stmt.ExecContext(ctx, ownerKey, key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Dissociate deletes the rows of the join table of the many_to_many relation
+// name linking owner to targets, in the transaction of ctx if there is one.
+func (b *Belvedere) Dissociate(ctx context.Context, owner interface{}, name string, targets ...interface{}) error {
+	r, ownerKey, targetKeys, err := manyToMany(owner, name, targets)
+	if err != nil {
+		return err
+	}
+	if len(targetKeys) == 0 {
+		return nil
+	}
+
+	som := b.selectOptionMap(Eq(r.fk, ownerKey), IN(r.ref, targetKeys...))
+	whereClause, whereParams, err := buildWhereClause(som.Wheres())
+	if err != nil {
+		return err
+	}
+
+	q := "DELETE FROM " + b.dialect.Quote(r.join) + whereClause
+	_, err = b.conn(ctx).ExecContext(ctx, b.dialect.Rebind(q), whereParams...)
+
+	return err
+}
@@ -0,0 +1,70 @@
+package belvedere
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// FindInBatches walks the table of dst in primary key order, filling dst
+// with at most batchSize rows at a time and calling fn for every batch.
+// Each batch is fetched with a `pk > last` predicate instead of OFFSET, so
+// later batches are as cheap as the first. Iteration stops at the first
+// error returned by fn.
+func (b *Belvedere) FindInBatches(ctx context.Context, dst interface{}, batchSize int, fn func(batch int) error, options ...NewSelectOption) error {
+	if batchSize <= 0 {
+		return errors.New("batch size must be greater than zero")
+	}
+
+	t, err := toSliceType(dst)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return errors.New("FindInBatches requires a pointer to a slice")
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	ti := newTableInfo(reflect.New(t).Interface())
+	if ti.Pk.Name == "" {
+		return ErrNoPrimaryKey
+	}
+
+	som := newSelectOptionMap(options...)
+	if som.Limit() != nil || som.Offset() != nil || len(som.Orders()) > 0 {
+		return ErrUnsupportedOption
+	}
+
+	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
+	var last interface{}
+	for batch := 0; ; batch++ {
+		batchOptions := append([]NewSelectOption{}, options...)
+		if last != nil {
+			batchOptions = append(batchOptions, Gt(ti.Pk.Name, last))
+		}
+		batchOptions = append(batchOptions, Order(ti.Pk.Name, OrderTypeAsc), Limit(batchSize))
+
+		sliceValue.Set(reflect.Zero(sliceValue.Type()))
+		if err := b.Select(ctx, dst, batchOptions...); err != nil {
+			return err
+		}
+
+		n := sliceValue.Len()
+		if n == 0 {
+			return nil
+		}
+
+		if err := fn(batch); err != nil {
+			return err
+		}
+
+		if n < batchSize {
+			return nil
+		}
+
+		last = reflect.Indirect(sliceValue.Index(n - 1)).Field(ti.Pk.Index).Interface()
+	}
+}
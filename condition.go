@@ -0,0 +1,164 @@
+package belvedere
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// comparison is a condition on a single column. format receives the
+	// quoted column name, followed by a `?` for each of args; an argument
+	// may be a *SubQuery.
+	comparison struct {
+		dialectHolder
+		column string
+		format string
+		args   []interface{}
+	}
+
+	whereStruct struct {
+		whereGroup
+		err error
+	}
+)
+
+func (c *comparison) Conditions() (string, error) {
+	placeholders, _, err := bindArgs(c.getDialect(), c.args)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(c.format, "?")
+	conditions := fmt.Sprintf(parts[0], c.quote(c.column))
+	for i, placeholder := range placeholders {
+		conditions += placeholder + parts[i+1]
+	}
+
+	return conditions, nil
+}
+
+func (c *comparison) Params() []interface{} {
+	_, params, _ := bindArgs(c.getDialect(), c.args)
+	return params
+}
+
+func (c *comparison) Type() SelectOptionType {
+	return selectOptionTypeWhere
+}
+
+func (ws *whereStruct) Conditions() (string, error) {
+	if ws.err != nil {
+		return "", ws.err
+	}
+
+	return ws.wrap("", " AND ")
+}
+
+func newComparison(column, format string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &comparison{
+			column: column,
+			format: format,
+			args:   args,
+		}
+	}
+}
+
+// Eq `column = value`
+func Eq(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s = ?", value)
+}
+
+// Ne `column <> value`
+func Ne(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s <> ?", value)
+}
+
+// Gt `column > value`
+func Gt(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s > ?", value)
+}
+
+// Gte `column >= value`
+func Gte(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s >= ?", value)
+}
+
+// Lt `column < value`
+func Lt(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s < ?", value)
+}
+
+// Lte `column <= value`
+func Lte(column string, value interface{}) NewSelectOption {
+	return newComparison(column, "%s <= ?", value)
+}
+
+// Between `column BETWEEN from AND to`
+func Between(column string, from, to interface{}) NewSelectOption {
+	return newComparison(column, "%s BETWEEN ? AND ?", from, to)
+}
+
+// Like `column LIKE pattern`
+func Like(column string, pattern string) NewSelectOption {
+	return newComparison(column, "%s LIKE ?", pattern)
+}
+
+// IsNull `column IS NULL`
+func IsNull(column string) NewSelectOption {
+	return newComparison(column, "%s IS NULL")
+}
+
+// IsNotNull `column IS NOT NULL`
+func IsNotNull(column string) NewSelectOption {
+	return newComparison(column, "%s IS NOT NULL")
+}
+
+// WhereStruct emits an equality condition for each field of model. When
+// fields are given, only those fields are used (either the Go field name or
+// the column name); otherwise every non-zero field is used. Unexported
+// fields are never used.
+func WhereStruct(model interface{}, fields ...string) NewSelectOption {
+	return func() SelectOption {
+		ws := &whereStruct{}
+		ti := newTableInfo(model)
+
+		selected := map[string]bool{}
+		for _, field := range fields {
+			selected[field] = false
+		}
+
+		for i := 0; i < ti.ColumnInfo.NumField(); i++ {
+			f := ti.ColumnInfo.Field(i)
+			if f.Anonymous || !isColumnField(f) {
+				continue
+			}
+
+			v := ti.ColumnValue.Field(i)
+			columnName := camelToSnake(f.Name)
+			if len(fields) > 0 {
+				_, byName := selected[f.Name]
+				_, byColumn := selected[columnName]
+				if !byName && !byColumn {
+					continue
+				}
+				delete(selected, f.Name)
+				delete(selected, columnName)
+				if f.PkgPath != "" {
+					ws.err = ErrUnexportedField
+					return ws
+				}
+			} else if f.PkgPath != "" || v.IsZero() {
+				continue
+			}
+
+			ws.newWheres = append(ws.newWheres, Eq(columnName, v.Interface()))
+		}
+
+		if len(selected) > 0 {
+			ws.err = ErrUnknownField
+		}
+
+		return ws
+	}
+}
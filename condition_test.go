@@ -0,0 +1,186 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComparison_Conditions(t *testing.T) {
+	tests := []struct {
+		name   string
+		option NewSelectOption
+		want   string
+		params []interface{}
+	}{
+		{
+			name:   "eq",
+			option: Eq("gendor", "male"),
+			want:   "`gendor` = ?",
+			params: []interface{}{"male"},
+		},
+		{
+			name:   "ne",
+			option: Ne("group", "admin"),
+			want:   "`group` <> ?",
+			params: []interface{}{"admin"},
+		},
+		{
+			name:   "gt",
+			option: Gt("age", 20),
+			want:   "`age` > ?",
+			params: []interface{}{20},
+		},
+		{
+			name:   "gte",
+			option: Gte("age", 20),
+			want:   "`age` >= ?",
+			params: []interface{}{20},
+		},
+		{
+			name:   "lt",
+			option: Lt("age", 20),
+			want:   "`age` < ?",
+			params: []interface{}{20},
+		},
+		{
+			name:   "lte",
+			option: Lte("user.age", 20),
+			want:   "`user`.`age` <= ?",
+			params: []interface{}{20},
+		},
+		{
+			name:   "between",
+			option: Between("age", 20, 30),
+			want:   "`age` BETWEEN ? AND ?",
+			params: []interface{}{20, 30},
+		},
+		{
+			name:   "like",
+			option: Like("name", "foo%"),
+			want:   "`name` LIKE ?",
+			params: []interface{}{"foo%"},
+		},
+		{
+			name:   "is null",
+			option: IsNull("deleted_at"),
+			want:   "`deleted_at` IS NULL",
+			params: nil,
+		},
+		{
+			name:   "is not null",
+			option: IsNotNull("deleted_at"),
+			want:   "`deleted_at` IS NOT NULL",
+			params: nil,
+		},
+		{
+			name:   "not in",
+			option: NotIn("id", 1, 2),
+			want:   "`id` NOT IN (?, ?)",
+			params: []interface{}{1, 2},
+		},
+		{
+			name:   "empty in",
+			option: IN("id"),
+			want:   "1 = 0",
+			params: nil,
+		},
+		{
+			name:   "empty not in",
+			option: NotIn("id"),
+			want:   "1 = 1",
+			params: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.option()
+			q, e := c.Conditions()
+			if q != tt.want {
+				t.Errorf("Conditions() result: %s expected value: %s", q, tt.want)
+			}
+			if e != nil {
+				t.Errorf("Conditions() err: %s", e)
+			}
+			if !reflect.DeepEqual(c.Params(), tt.params) {
+				t.Errorf("Params() result: %v expected value: %v", c.Params(), tt.params)
+			}
+		})
+	}
+}
+
+func TestWhereStruct(t *testing.T) {
+	type Member struct {
+		ID     uint64 `pk:"true"`
+		Name   string
+		Age    uint
+		Gendor string
+		secret string
+	}
+
+	tests := []struct {
+		name   string
+		model  interface{}
+		fields []string
+		want   string
+		params []interface{}
+		err    error
+	}{
+		{
+			name:   "non-zero fields",
+			model:  &Member{Gendor: "male", Age: 20, secret: "foo"},
+			fields: nil,
+			want:   "(`age` = ? AND `gendor` = ?)",
+			params: []interface{}{uint(20), "male"},
+			err:    nil,
+		},
+		{
+			name:   "selected fields",
+			model:  Member{Name: "foo", Age: 0},
+			fields: []string{"Age", "name"},
+			want:   "(`name` = ? AND `age` = ?)",
+			params: []interface{}{"foo", uint(0)},
+			err:    nil,
+		},
+		{
+			name:   "unknown field",
+			model:  Member{},
+			fields: []string{"email"},
+			want:   "",
+			params: []interface{}{},
+			err:    ErrUnknownField,
+		},
+		{
+			name:   "unexported field",
+			model:  Member{secret: "foo"},
+			fields: []string{"secret"},
+			want:   "",
+			params: []interface{}{},
+			err:    ErrUnexportedField,
+		},
+		{
+			name:   "zero value model",
+			model:  Member{},
+			fields: nil,
+			want:   "",
+			params: []interface{}{},
+			err:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := WhereStruct(tt.model, tt.fields...)()
+			q, e := ws.Conditions()
+			if q != tt.want {
+				t.Errorf("whereStruct.Conditions() result: %s expected value: %s", q, tt.want)
+			}
+			if e != tt.err {
+				t.Errorf("whereStruct.Conditions() err: %v expected value: %v", e, tt.err)
+			}
+			if !reflect.DeepEqual(ws.Params(), tt.params) {
+				t.Errorf("whereStruct.Params() result: %v expected value: %v", ws.Params(), tt.params)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package belvedere
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+)
+
+// Cursor iterates over the result of a query one struct at a time, so large
+// result sets never have to be held in memory.
+type Cursor struct {
+	ctx             context.Context
+	rows            *sql.Rows
+	t               reflect.Type
+	colToFieldIndex [][]int
+	err             error
+}
+
+// Rows runs a SELECT for the table of model and returns a Cursor over the
+// result.
+func (b *Belvedere) Rows(ctx context.Context, model interface{}, options ...NewSelectOption) (*Cursor, error) {
+	t := reflect.Indirect(reflect.ValueOf(model)).Type()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("belvedere: cannot iterate over a non-struct model: %v", t)
+	}
+
+	som := b.selectOptionMap(options...)
+	if err := checkLock(ctx, som); err != nil {
+		return nil, err
+	}
+
+	q, params, err := b.buildSelectQuery(t, som)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	colToFieldIndex, err := columnToFieldIndex(t, cols)
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	return &Cursor{
+		ctx:             ctx,
+		rows:            rows,
+		t:               t,
+		colToFieldIndex: colToFieldIndex,
+	}, nil
+}
+
+// Next prepares the next row for Scan. It returns false when the rows are
+// exhausted, an error occurred or the context was cancelled.
+func (c *Cursor) Next() bool {
+	if c.err != nil {
+		return false
+	}
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return false
+	}
+
+	return c.rows.Next()
+}
+
+// Scan copies the current row into dst, which must be a pointer to the
+// model struct.
+func (c *Cursor) Scan(dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Type() != c.t {
+		return fmt.Errorf("belvedere: cannot scan into %v, want *%v", reflect.TypeOf(dst), c.t)
+	}
+
+	return c.rows.Scan(fieldAddrs(v.Elem(), c.colToFieldIndex)...)
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (c *Cursor) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return c.rows.Err()
+}
+
+// Close closes the underlying rows. It is safe to call Close more than once.
+func (c *Cursor) Close() error {
+	return c.rows.Close()
+}
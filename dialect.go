@@ -0,0 +1,194 @@
+package belvedere
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Dialect renders the database specific parts of a query.
+	Dialect interface {
+		Name() string
+		// Quote quotes an identifier. Qualified names such as `user.id`
+		// are quoted part by part.
+		Quote(identifier string) string
+		// SortKey renders a single ORDER BY key.
+		SortKey(expr string, oType OrderType, nulls NullsOrder) string
+		// Rebind rewrites the `?` placeholders of query into the
+		// placeholders of the dialect.
+		Rebind(query string) string
+	}
+
+	mysqlDialect struct{}
+
+	postgresDialect struct{}
+
+	// dialectOption is implemented by options that render identifiers.
+	dialectOption interface {
+		setDialect(d Dialect)
+	}
+
+	dialectHolder struct {
+		dialect Dialect
+	}
+)
+
+var defaultDialect Dialect = mysqlDialect{}
+
+var repIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)*$`)
+
+func dialectFor(driver string) Dialect {
+	switch driver {
+	case "mysql":
+		return mysqlDialect{}
+	case "postgres", "pgx":
+		return postgresDialect{}
+	default:
+		return defaultDialect
+	}
+}
+
+func quoteIdentifier(identifier string, q string) string {
+	parts := strings.Split(identifier, ".")
+	for i, part := range parts {
+		if part == "*" {
+			continue
+		}
+		parts[i] = q + strings.Replace(part, q, q+q, -1) + q
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func quoteAll(d Dialect, identifiers []string) []string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = d.Quote(identifier)
+	}
+
+	return quoted
+}
+
+// validateIdentifier reports whether name can safely be used as a column or
+// table name.
+func validateIdentifier(name string) error {
+	if !repIdentifier.MatchString(name) {
+		return ErrInvalidIdentifier
+	}
+
+	return nil
+}
+
+// mysql
+func (mysqlDialect) Name() string {
+	return "mysql"
+}
+
+func (mysqlDialect) Quote(identifier string) string {
+	return quoteIdentifier(identifier, "`")
+}
+
+// MySQL has no NULLS FIRST / NULLS LAST, so NULL ordering is emulated with an
+// extra `IS NULL` key.
+func (mysqlDialect) SortKey(expr string, oType OrderType, nulls NullsOrder) string {
+	key := expr + " " + oType.String()
+	switch nulls {
+	case NullsFirst:
+		return expr + " IS NULL DESC, " + key
+	case NullsLast:
+		return expr + " IS NULL ASC, " + key
+	default:
+		return key
+	}
+}
+
+func (mysqlDialect) Rebind(query string) string {
+	return query
+}
+
+// postgres
+func (postgresDialect) Name() string {
+	return "postgres"
+}
+
+func (postgresDialect) Quote(identifier string) string {
+	return quoteIdentifier(identifier, `"`)
+}
+
+func (postgresDialect) SortKey(expr string, oType OrderType, nulls NullsOrder) string {
+	key := expr + " " + oType.String()
+	switch nulls {
+	case NullsFirst:
+		return key + " NULLS FIRST"
+	case NullsLast:
+		return key + " NULLS LAST"
+	default:
+		return key
+	}
+}
+
+func (postgresDialect) Rebind(query string) string {
+	var buf strings.Builder
+	n := 0
+	for i := 0; i < len(query); {
+		if j := skipQuoted(query, i); j > i {
+			buf.WriteString(query[i:j])
+			i = j
+			continue
+		}
+
+		if query[i] == '?' {
+			n++
+			buf.WriteString("$" + strconv.Itoa(n))
+		} else {
+			buf.WriteByte(query[i])
+		}
+		i++
+	}
+
+	return buf.String()
+}
+
+// skipQuoted returns the index just after the quoted string or identifier
+// starting at query[i], or i if there is none.
+func skipQuoted(query string, i int) int {
+	q := query[i]
+	if q != '\'' && q != '"' && q != '`' {
+		return i
+	}
+
+	for j := i + 1; j < len(query); j++ {
+		switch query[j] {
+		case '\\':
+			if q != '`' {
+				j++
+			}
+		case q:
+			if j+1 < len(query) && query[j+1] == q {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+
+	return len(query)
+}
+
+// dialect holder
+func (h *dialectHolder) setDialect(d Dialect) {
+	h.dialect = d
+}
+
+func (h *dialectHolder) getDialect() Dialect {
+	if h.dialect == nil {
+		return defaultDialect
+	}
+
+	return h.dialect
+}
+
+func (h *dialectHolder) quote(identifier string) string {
+	return h.getDialect().Quote(identifier)
+}
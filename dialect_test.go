@@ -0,0 +1,109 @@
+package belvedere
+
+import "testing"
+
+func TestMysqlDialect_Quote(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "reserved word",
+			in:   "group",
+			want: "`group`",
+		},
+		{
+			name: "qualified name",
+			in:   "user.id",
+			want: "`user`.`id`",
+		},
+		{
+			name: "all columns",
+			in:   "user.*",
+			want: "`user`.*",
+		},
+		{
+			name: "escape backtick",
+			in:   "na`me",
+			want: "`na``me`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := mysqlDialect{}.Quote(tt.in)
+			if q != tt.want {
+				t.Errorf("mysqlDialect.Quote() result: %s expected value: %s", q, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		err  error
+	}{
+		{
+			name: "column",
+			in:   "created_at",
+			err:  nil,
+		},
+		{
+			name: "qualified column",
+			in:   "user.created_at",
+			err:  nil,
+		},
+		{
+			name: "expression",
+			in:   "COUNT(id)",
+			err:  ErrInvalidIdentifier,
+		},
+		{
+			name: "injection",
+			in:   "id`; DROP TABLE user; --",
+			err:  ErrInvalidIdentifier,
+		},
+		{
+			name: "empty",
+			in:   "",
+			err:  ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := validateIdentifier(tt.in)
+			if e != tt.err {
+				t.Errorf("validateIdentifier() err: %v expected value: %v", e, tt.err)
+			}
+		})
+	}
+}
+
+func TestBuildUpdateQuery(t *testing.T) {
+	q := buildUpdateQuery(mysqlDialect{}, "user", []string{"name", "order"}, " WHERE `id` = ?")
+	want := "UPDATE `user` SET `name` = ?, `order` = ? WHERE `id` = ?"
+	if q != want {
+		t.Errorf("buildUpdateQuery() result: %s expected value: %s", q, want)
+	}
+}
+
+func TestPostgresDialect(t *testing.T) {
+	d := postgresDialect{}
+	if q := d.Quote("user.order"); q != `"user"."order"` {
+		t.Errorf("postgresDialect.Quote() result: %s expected value: %s", q, `"user"."order"`)
+	}
+
+	if k := d.SortKey(`"deleted_at"`, OrderTypeAsc, NullsFirst); k != `"deleted_at" ASC NULLS FIRST` {
+		t.Errorf("postgresDialect.SortKey() result: %s expected value: %s", k, `"deleted_at" ASC NULLS FIRST`)
+	}
+
+	q := d.Rebind(`SELECT * FROM "user" WHERE name = '?' AND "a?" = ? AND age > ? LIMIT ?`)
+	want := `SELECT * FROM "user" WHERE name = '?' AND "a?" = $1 AND age > $2 LIMIT $3`
+	if q != want {
+		t.Errorf("postgresDialect.Rebind() result: %s expected value: %s", q, want)
+	}
+}
@@ -3,3 +3,17 @@ package belvedere
 import "errors"
 
 var ErrDifferentOptionType = errors.New("different option type")
+
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+var ErrUnknownField = errors.New("unknown field")
+
+var ErrUnexportedField = errors.New("unexported field")
+
+var ErrNoPrimaryKey = errors.New("no primary key")
+
+var ErrUnsupportedOption = errors.New("unsupported option")
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+var ErrNotInTransaction = errors.New("not in transaction")
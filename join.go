@@ -0,0 +1,162 @@
+package belvedere
+
+import (
+	"reflect"
+	"strings"
+)
+
+type (
+	join struct {
+		dialectHolder
+		kind  string
+		table string
+		alias string
+		on    string
+		args  []interface{}
+	}
+
+	// compositeField is a model nested in a composite struct, e.g.
+	//
+	//	type UserPost struct {
+	//		User `alias:"u"`
+	//		Post Post `alias:"p"`
+	//	}
+	//
+	// Its columns are selected as `alias__column`.
+	compositeField struct {
+		alias   string
+		table   string
+		columns []string
+		indexes [][]int
+	}
+)
+
+// compositeSeparator separates the alias from the column name in the
+// columns selected for a composite struct.
+const compositeSeparator = "__"
+
+// join
+func (j *join) Conditions() (string, error) {
+	if err := validateIdentifier(j.table); err != nil {
+		return "", err
+	}
+
+	c := " " + j.kind + " JOIN " + j.quote(j.table)
+	if j.alias != "" {
+		if err := validateIdentifier(j.alias); err != nil {
+			return "", err
+		}
+		c += " AS " + j.quote(j.alias)
+	}
+
+	return c + " ON " + j.on, nil
+}
+
+func (j *join) Params() []interface{} {
+	return j.args
+}
+
+func (j *join) Type() SelectOptionType {
+	return selectOptionTypeJoin
+}
+
+func buildJoinClause(selectOptions []SelectOption) (string, []interface{}, error) {
+	var buf strings.Builder
+	var values []interface{}
+	for _, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", values, err
+		}
+		buf.WriteString(c)
+		values = append(values, option.Params()...)
+	}
+
+	return buf.String(), values, nil
+}
+
+// compositeFields returns the models nested in t with an alias tag. The
+// first one is the table the query selects from.
+func compositeFields(t reflect.Type) []compositeField {
+	var fields []compositeField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		alias := f.Tag.Get("alias")
+		if alias == "" || f.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		field := compositeField{
+			alias: alias,
+			table: getTableNameFromTypeName(f.Type),
+		}
+		for j := 0; j < f.Type.NumField(); j++ {
+			mf := f.Type.Field(j)
+			if mf.Anonymous || !isColumnField(mf) {
+				continue
+			}
+			field.columns = append(field.columns, camelToSnake(mf.Name))
+			field.indexes = append(field.indexes, []int{i, j})
+		}
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// compositeFieldIndex returns the index of the field an `alias__column`
+// column is scanned into.
+func compositeFieldIndex(fields []compositeField, colName string) []int {
+	for _, field := range fields {
+		prefix := strings.ToLower(field.alias) + compositeSeparator
+		if !strings.HasPrefix(colName, prefix) {
+			continue
+		}
+
+		for i, column := range field.columns {
+			if column == colName[len(prefix):] {
+				return field.indexes[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *Belvedere) compositeSelectList(fields []compositeField) string {
+	var columns []string
+	for _, field := range fields {
+		for _, column := range field.columns {
+			columns = append(columns, b.dialect.Quote(field.alias+"."+column)+" AS "+b.dialect.Quote(field.alias+compositeSeparator+column))
+		}
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// InnerJoin joins table under alias (optional) with a raw ON condition.
+func InnerJoin(table, alias, on string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &join{
+			kind:  "INNER",
+			table: table,
+			alias: alias,
+			on:    on,
+			args:  args,
+		}
+	}
+}
+
+// LeftJoin joins table under alias (optional) with a raw ON condition.
+// Fields of a model that may be missing should be nullable types.
+func LeftJoin(table, alias, on string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &join{
+			kind:  "LEFT",
+			table: table,
+			alias: alias,
+			on:    on,
+			args:  args,
+		}
+	}
+}
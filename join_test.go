@@ -0,0 +1,69 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+type (
+	Post struct {
+		ID     uint64 `pk:"true"`
+		UserID uint64
+		Title  string
+	}
+
+	UserPost struct {
+		User `alias:"u"`
+		Post Post `alias:"p"`
+	}
+)
+
+func TestBuildJoinClause(t *testing.T) {
+	som := newSelectOptionMap(
+		InnerJoin("post", "p", "p.user_id = u.id AND p.published = ?", 1),
+		LeftJoin("comment", "", "comment.post_id = p.id"),
+	)
+	q, p, e := buildJoinClause(som.Joins())
+	want := " INNER JOIN `post` AS `p` ON p.user_id = u.id AND p.published = ? LEFT JOIN `comment` ON comment.post_id = p.id"
+	if q != want {
+		t.Errorf("buildJoinClause() result: %s expected value: %s", q, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{1}) {
+		t.Errorf("buildJoinClause() params: %v expected value: %v", p, []interface{}{1})
+	}
+	if e != nil {
+		t.Errorf("buildJoinClause() err: %s", e)
+	}
+}
+
+func TestBuildSelectQuery_Composite(t *testing.T) {
+	b := &Belvedere{dialect: mysqlDialect{}}
+	som := newSelectOptionMap(
+		InnerJoin("post", "p", "p.user_id = u.id"),
+		Gt("u.id", 10),
+	)
+	som.setDialect(b.dialect)
+
+	q, p, e := b.buildSelectQuery(reflect.TypeOf(UserPost{}), som)
+	want := "SELECT `u`.`id` AS `u__id`, `u`.`name` AS `u__name`, `u`.`profile` AS `u__profile`, " +
+		"`u`.`created_at` AS `u__created_at`, `u`.`updated_at` AS `u__updated_at`, " +
+		"`p`.`id` AS `p__id`, `p`.`user_id` AS `p__user_id`, `p`.`title` AS `p__title` " +
+		"FROM `user` AS `u` INNER JOIN `post` AS `p` ON p.user_id = u.id WHERE `u`.`id` > ?"
+	if q != want {
+		t.Errorf("buildSelectQuery() result: %s expected value: %s", q, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{10}) {
+		t.Errorf("buildSelectQuery() params: %v expected value: %v", p, []interface{}{10})
+	}
+	if e != nil {
+		t.Errorf("buildSelectQuery() err: %s", e)
+	}
+
+	index, e := columnToFieldIndex(reflect.TypeOf(UserPost{}), []string{"u__name", "p__title"})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(index, [][]int{{0, 1}, {1, 2}}) {
+		t.Errorf("columnToFieldIndex() result: %v expected value: %v", index, [][]int{{0, 1}, {1, 2}})
+	}
+}
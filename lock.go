@@ -0,0 +1,102 @@
+package belvedere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// lock is a locking clause, or one of its modifiers SKIP LOCKED and NOWAIT.
+type lock struct {
+	clause   string
+	modifier bool
+}
+
+func (l *lock) Conditions() (string, error) {
+	return l.clause, nil
+}
+
+func (l *lock) Params() []interface{} {
+	return []interface{}{}
+}
+
+func (l *lock) Type() SelectOptionType {
+	return selectOptionTypeLock
+}
+
+// buildLockClause renders the locking clause following LIMIT and OFFSET.
+func buildLockClause(selectOptions []SelectOption) (string, error) {
+	if len(selectOptions) == 0 {
+		return "", nil
+	}
+
+	var strength, modifier string
+	for _, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", err
+		}
+
+		current := &strength
+		if option.(*lock).modifier {
+			current = &modifier
+		}
+		if *current != "" && *current != c {
+			return "", fmt.Errorf("belvedere: %s conflicts with %s", c, *current)
+		}
+		*current = c
+	}
+
+	if strength == "" {
+		return "", errors.New("belvedere: SkipLocked and NoWait require ForUpdate or ForShare")
+	}
+	if modifier == "" {
+		return " " + strength, nil
+	}
+
+	return " " + strength + " " + modifier, nil
+}
+
+// checkLock returns ErrNotInTransaction if som locks rows outside of a
+// transaction, where the locks would be released as soon as the query ends.
+func checkLock(ctx context.Context, som SelectOptionMap) error {
+	if len(som.Locks()) == 0 {
+		return nil
+	}
+	if _, ok := txFromContext(ctx); !ok {
+		return ErrNotInTransaction
+	}
+
+	return nil
+}
+
+// ForUpdate locks the selected rows for update until the transaction ends.
+func ForUpdate() NewSelectOption {
+	return func() SelectOption {
+		return &lock{clause: "FOR UPDATE"}
+	}
+}
+
+// ForShare locks the selected rows against updates until the transaction
+// ends.
+func ForShare() NewSelectOption {
+	return func() SelectOption {
+		return &lock{clause: "FOR SHARE"}
+	}
+}
+
+// SkipLocked skips the rows locked by other transactions instead of waiting,
+// e.g. to take jobs from a queue.
+func SkipLocked() NewSelectOption {
+	return func() SelectOption {
+		return &lock{clause: "SKIP LOCKED", modifier: true}
+	}
+}
+
+// NoWait fails instead of waiting when a selected row is locked by another
+// transaction.
+func NoWait() NewSelectOption {
+	return func() SelectOption {
+		return &lock{clause: "NOWAIT", modifier: true}
+	}
+}
@@ -0,0 +1,92 @@
+package belvedere
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestBuildLockClause(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []NewSelectOption
+		want     string
+		hasError bool
+	}{
+		{
+			name:    "for update",
+			options: []NewSelectOption{ForUpdate()},
+			want:    " FOR UPDATE",
+		},
+		{
+			name:    "for update skip locked",
+			options: []NewSelectOption{SkipLocked(), ForUpdate()},
+			want:    " FOR UPDATE SKIP LOCKED",
+		},
+		{
+			name:    "for share nowait",
+			options: []NewSelectOption{ForShare(), NoWait()},
+			want:    " FOR SHARE NOWAIT",
+		},
+		{
+			name:     "skip locked without lock",
+			options:  []NewSelectOption{SkipLocked()},
+			hasError: true,
+		},
+		{
+			name:     "conflicting locks",
+			options:  []NewSelectOption{ForUpdate(), ForShare()},
+			hasError: true,
+		},
+		{
+			name:     "conflicting modifiers",
+			options:  []NewSelectOption{ForUpdate(), SkipLocked(), NoWait()},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, e := buildLockClause(newSelectOptionMap(tt.options...).Locks())
+			if tt.hasError {
+				if e == nil {
+					t.Errorf("buildLockClause() should fail")
+				}
+				return
+			}
+			if e != nil {
+				t.Fatal(e)
+			}
+			if c != tt.want {
+				t.Errorf("buildLockClause() result: %s expected value: %s", c, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildSelectQuery_Lock(t *testing.T) {
+	b := &Belvedere{dialect: postgresDialect{}}
+	som := b.selectOptionMap(Eq("name", "foo"), Order("id", OrderTypeAsc), Limit(1), ForUpdate(), SkipLocked())
+	q, p, e := b.buildSelectQuery(reflect.TypeOf(User{}), som)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	want := `SELECT * FROM "user" WHERE "name" = ? ORDER BY "id" ASC LIMIT ? FOR UPDATE SKIP LOCKED`
+	if q != want {
+		t.Errorf("buildSelectQuery() result: %s expected value: %s", q, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{"foo", 1}) {
+		t.Errorf("buildSelectQuery() params: %v expected value: %v", p, []interface{}{"foo", 1})
+	}
+}
+
+func TestCheckLock(t *testing.T) {
+	som := newSelectOptionMap(ForUpdate())
+	if e := checkLock(context.Background(), som); e != ErrNotInTransaction {
+		t.Errorf("checkLock() err: %v expected value: %v", e, ErrNotInTransaction)
+	}
+	if e := checkLock(context.Background(), newSelectOptionMap(Limit(1))); e != nil {
+		t.Errorf("checkLock() err: %v", e)
+	}
+}
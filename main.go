@@ -16,14 +16,33 @@ type (
 	QueryBuilder interface {
 		Insert(ctx context.Context, src interface{}) (sql.Result, error)
 		Update(ctx context.Context, src interface{}) (sql.Result, error)
-		SelectOne(ctx context.Context, dst interface{}) error
+		SelectOne(ctx context.Context, dst interface{}, options ...NewSelectOption) error
+		First(ctx context.Context, dst interface{}, options ...NewSelectOption) error
 		Select(ctx context.Context, dst interface{}, options ...NewSelectOption) error
+		Rows(ctx context.Context, model interface{}, options ...NewSelectOption) (*Cursor, error)
+		FindInBatches(ctx context.Context, dst interface{}, batchSize int, fn func(batch int) error, options ...NewSelectOption) error
+		Page(ctx context.Context, dst interface{}, page, perPage int, options ...NewSelectOption) (*Pagination, error)
 		Count(ctx context.Context, fn string, dst interface{}, options ...NewSelectOption) (int, error)
+		Sum(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error
+		Avg(ctx context.Context, column string, dst interface{}, options ...NewSelectOption) (sql.NullFloat64, error)
+		Min(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error
+		Max(ctx context.Context, column string, dst interface{}, result interface{}, options ...NewSelectOption) error
+		CountBy(ctx context.Context, column string, dst interface{}, options ...NewSelectOption) ([]GroupCount, error)
+		Exists(ctx context.Context, dst interface{}, options ...NewSelectOption) (bool, error)
+		Pluck(ctx context.Context, model interface{}, column string, dst interface{}, options ...NewSelectOption) error
+		Raw(ctx context.Context, dst interface{}, query string, args ...interface{}) error
+		RawOne(ctx context.Context, dst interface{}, query string, args ...interface{}) error
+		SelectMaps(ctx context.Context, table string, options ...NewSelectOption) ([]map[string]interface{}, error)
+		SelectUnion(ctx context.Context, dst interface{}, u *UnionQuery, options ...NewSelectOption) error
+		Associate(ctx context.Context, owner interface{}, name string, targets ...interface{}) error
+		Dissociate(ctx context.Context, owner interface{}, name string, targets ...interface{}) error
+		Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 	}
 
 	// Belvedere query builder struct
 	Belvedere struct {
-		db *sql.DB
+		db      *sql.DB
+		dialect Dialect
 	}
 )
 
@@ -44,10 +63,16 @@ func (b *Belvedere) DB() *sql.DB {
 	return b.db
 }
 
+func (b *Belvedere) selectOptionMap(options ...NewSelectOption) SelectOptionMap {
+	som := newSelectOptionMap(options...)
+	som.setDialect(b.dialect)
+	return som
+}
+
 // Insert
 func (b *Belvedere) Insert(ctx context.Context, src interface{}) (sql.Result, error) {
 	tableInfo := newTableInfo(src)
-	columnNames := tableInfo.ColumnNames(true)
+	columnNames := strings.Join(quoteAll(b.dialect, tableInfo.ColumnNameList(true)), ",")
 	values, e := tableInfo.Values(true)
 
 	if e != nil {
@@ -55,9 +80,9 @@ func (b *Belvedere) Insert(ctx context.Context, src interface{}) (sql.Result, er
 	}
 
 	statementString := tableInfo.StatementString(true)
-	q := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s)", tableInfo.Name, columnNames, statementString)
+	q := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s)", b.dialect.Quote(tableInfo.Name), columnNames, statementString)
 
-	stmt, e := b.db.PrepareContext(ctx, q)
+	stmt, e := b.conn(ctx).PrepareContext(ctx, b.dialect.Rebind(q))
 
 	if e != nil {
 		return nil, e
@@ -72,16 +97,15 @@ func (b *Belvedere) Insert(ctx context.Context, src interface{}) (sql.Result, er
 	return result, nil
 }
 
-func buildUpdateQuery(tableName, columnNames string, whereClause string) string {
-	cns := strings.Split(columnNames, ",")
-	length := len(cns)
+func buildUpdateQuery(d Dialect, tableName string, columnNames []string, whereClause string) string {
+	length := len(columnNames)
 	var b []byte
 	b = append(b, "UPDATE "...)
-	b = append(b, tableName...)
+	b = append(b, d.Quote(tableName)...)
 	b = append(b, " SET "...)
 
-	for i, cn := range cns {
-		b = append(b, cn...)
+	for i, cn := range columnNames {
+		b = append(b, d.Quote(cn)...)
 		b = append(b, " = ?"...)
 		if i < length-1 {
 			b = append(b, ", "...)
@@ -95,7 +119,7 @@ func buildUpdateQuery(tableName, columnNames string, whereClause string) string
 
 func (b *Belvedere) Update(ctx context.Context, src interface{}) (sql.Result, error) {
 	tableInfo := newTableInfo(src)
-	columnNames := tableInfo.ColumnNames(true)
+	columnNames := tableInfo.ColumnNameList(true)
 	values, e := tableInfo.Values(true)
 
 	if e != nil {
@@ -103,7 +127,7 @@ func (b *Belvedere) Update(ctx context.Context, src interface{}) (sql.Result, er
 	}
 
 	var conditions []byte
-	conditions = append(conditions, tableInfo.Pk.Name...)
+	conditions = append(conditions, b.dialect.Quote(tableInfo.Pk.Name)...)
 	conditions = append(conditions, " = ?"...)
 
 	pkv, err := tableInfo.PkValue()
@@ -120,12 +144,13 @@ func (b *Belvedere) Update(ctx context.Context, src interface{}) (sql.Result, er
 	}
 
 	q := buildUpdateQuery(
+		b.dialect,
 		tableInfo.Name,
 		columnNames,
 		whereClause,
 	)
 
-	stmt, e := b.db.PrepareContext(ctx, q)
+	stmt, e := b.conn(ctx).PrepareContext(ctx, b.dialect.Rebind(q))
 
 	if e != nil {
 		return nil, e
@@ -141,12 +166,25 @@ func (b *Belvedere) Update(ctx context.Context, src interface{}) (sql.Result, er
 	return result, nil
 }
 
-func (b *Belvedere) SelectOne(ctx context.Context, dst interface{}) error {
+func (b *Belvedere) SelectOne(ctx context.Context, dst interface{}, options ...NewSelectOption) error {
 	tableInfo := newTableInfo(dst)
-	q := fmt.Sprintf("SELECT * FROM %s", tableInfo.Name)
+	som := b.selectOptionMap(options...)
+	if err := som.only(selectOptionTypePreload, selectOptionTypeLock); err != nil {
+		return err
+	}
+	if err := checkLock(ctx, som); err != nil {
+		return err
+	}
+
+	lockClause, err := buildLockClause(som.Locks())
+	if err != nil {
+		return err
+	}
+
+	q := fmt.Sprintf("SELECT * FROM %s", b.dialect.Quote(tableInfo.Name))
 
 	var conditions []byte
-	conditions = append(conditions, tableInfo.Pk.Name...)
+	conditions = append(conditions, b.dialect.Quote(tableInfo.Pk.Name)...)
 	conditions = append(conditions, " = ?"...)
 
 	pkv, err := tableInfo.PkValue()
@@ -162,34 +200,87 @@ func (b *Belvedere) SelectOne(ctx context.Context, dst interface{}) error {
 		return err
 	}
 
-	q = q + whereClause
+	q = q + whereClause + lockClause
 
-	stmt, e := b.db.PrepareContext(ctx, q)
+	rows, e := b.query(ctx, q, whereParams)
 	if e != nil {
 		return e
 	}
 
-	rows, e := stmt.QueryContext(ctx, whereParams...)
+	defer rows.Close()
+
+	cols, e := rows.Columns()
 	if e != nil {
 		return e
 	}
 
-	defer rows.Close()
+	colToFieldIndex, e := columnToFieldIndex(tableInfo.ColumnInfo, cols)
+	if e != nil {
+		return e
+	}
 
+	found := false
 	for rows.Next() {
-		pts, e := tableInfo.FieldPts()
-		if e != nil {
+		if e = rows.Scan(fieldAddrs(tableInfo.ColumnValue, colToFieldIndex)...); e != nil {
 			return e
 		}
+		found = true
+	}
 
-		if e = rows.Scan(pts...); e != nil {
-			return e
-		}
+	if e = rows.Err(); e != nil {
+		return e
+	}
 
-		tableInfo.SetValue(pts)
+	if !found {
+		return nil
 	}
 
-	return nil
+	return b.preload(ctx, []reflect.Value{tableInfo.ColumnValue}, som.Preloads())
+}
+
+// First selects the first row matching options into the struct dst points
+// to, ordered by the primary key unless Order options are given. It returns
+// sql.ErrNoRows when no row matches.
+func (b *Belvedere) First(ctx context.Context, dst interface{}, options ...NewSelectOption) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("belvedere: cannot scan into a non-pointer struct: %v", reflect.TypeOf(dst))
+	}
+
+	som := b.selectOptionMap(options...)
+	if som.Limit() != nil || som.Paginate() != nil {
+		return ErrUnsupportedOption
+	}
+	if err := checkLock(ctx, som); err != nil {
+		return err
+	}
+
+	var firstOptions []NewSelectOption
+	tableInfo := newTableInfo(dst)
+	if len(som.Orders()) == 0 && tableInfo.Pk.Name != "" {
+		firstOptions = append(firstOptions, Order(tableInfo.Pk.Name, OrderTypeAsc))
+	}
+	firstOptions = append(firstOptions, Limit(1))
+	for k, o := range b.selectOptionMap(firstOptions...) {
+		som[k] = append(som[k], o...)
+	}
+
+	q, params, err := b.buildSelectQuery(v.Elem().Type(), som)
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if err := scanRow(rows, v.Elem()); err != nil {
+		return err
+	}
+
+	return b.preload(ctx, []reflect.Value{v.Elem()}, som.Preloads())
 }
 
 func toSliceType(i interface{}) (reflect.Type, error) {
@@ -208,16 +299,23 @@ func toSliceType(i interface{}) (reflect.Type, error) {
 
 func columnToFieldIndex(t reflect.Type, cols []string) ([][]int, error) {
 	colToFieldIndex := make([][]int, len(cols))
+	fields := compositeFields(t)
 
 	missingColNames := []string{}
 	for x := range cols {
 		colName := strings.ToLower(cols[x])
-		field, found := t.FieldByNameFunc(func(fieldName string) bool {
-			return colName == camelToSnake(fieldName)
-		})
+		if len(fields) > 0 {
+			colToFieldIndex[x] = compositeFieldIndex(fields, colName)
+		}
+		// Computed columns of a composite struct are its own fields.
+		if colToFieldIndex[x] == nil {
+			field, found := t.FieldByNameFunc(func(fieldName string) bool {
+				return colName == camelToSnake(fieldName)
+			})
 
-		if found {
-			colToFieldIndex[x] = field.Index
+			if found {
+				colToFieldIndex[x] = field.Index
+			}
 		}
 		if colToFieldIndex[x] == nil {
 			missingColNames = append(missingColNames, colName)
@@ -232,65 +330,158 @@ func columnToFieldIndex(t reflect.Type, cols []string) ([][]int, error) {
 	return colToFieldIndex, nil
 }
 
-func (b *Belvedere) Select(ctx context.Context, dst interface{}, options ...NewSelectOption) error {
-	t, err := toSliceType(dst)
+// buildSelectQuery builds a SELECT statement for the table of t. When t is
+// a composite struct, the columns of every model are selected with their
+// alias as prefix. Columns options replace the select list and SelectExpr
+// options are appended to it.
+func (b *Belvedere) buildSelectQuery(t reflect.Type, som SelectOptionMap) (string, []interface{}, error) {
+	columnList, err := buildColumnList(som.Columns())
+	if err != nil {
+		return "", nil, err
+	}
+
+	tn := getTableNameFromTypeName(t)
+	if fields := compositeFields(t); len(fields) > 0 {
+		if columnList == "" {
+			columnList = b.compositeSelectList(fields)
+		}
+		return b.buildQuery(columnList, b.from(fields[0].table, fields[0].alias), som)
+	}
+
+	if columnList == "" && (len(som.Joins()) > 0 || len(som.SelectExprs()) > 0) {
+		columnList = b.dialect.Quote(tn + ".*")
+	} else if columnList == "" {
+		columnList = "*"
+	}
+
+	return b.buildQuery(columnList, b.from(tn, ""), som)
+}
+
+// from renders a table reference with an optional alias.
+func (b *Belvedere) from(tableName, alias string) string {
+	if alias == "" {
+		return b.dialect.Quote(tableName)
+	}
+
+	return b.dialect.Quote(tableName) + " AS " + b.dialect.Quote(alias)
+}
 
+// buildQuery builds `SELECT selectList FROM from` preceded by the WITH clause
+// and followed by the other clauses of som.
+func (b *Belvedere) buildQuery(selectList, from string, som SelectOptionMap) (string, []interface{}, error) {
+	withClause, withParams, err := buildWithClause(som.Withs())
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
-	isPtr := t.Kind() == reflect.Ptr
-	if isPtr {
-		t = t.Elem()
+	exprList, exprParams, err := buildSelectExprList(som.SelectExprs())
+	if err != nil {
+		return "", nil, err
+	}
+	if exprList != "" {
+		selectList = selectList + ", " + exprList
+	}
+
+	if d := som.Distinct(); d != nil {
+		c, _ := d.Conditions()
+		selectList = c + selectList
+	}
+
+	q := fmt.Sprintf("%sSELECT %s FROM %s", withClause, selectList, from)
+	joinClause, joinParams, err := buildJoinClause(som.Joins())
+	if err != nil {
+		return "", nil, err
 	}
 
-	tn := getTableNameFromTypeName(t)
-	q := fmt.Sprintf("SELECT * FROM %s", tn)
-	som := newSelectOptionMap(options...)
 	whereClause, whereParams, err := buildWhereClause(som.Wheres())
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	limitClause, limitParams, err := buildLimitClause(som.Limit())
 	if err != nil {
-		return err
+		return "", nil, err
+	}
+
+	orderClause, orderParams, err := buildOrderClause(som.Orders())
+	if err != nil {
+		return "", nil, err
 	}
 
-	orderClause, _ := buildOrderClause(som.Order())
 	offsetClause, offsetParams, _ := buildOffsetClause(som.Offset())
 
-	groupByClause, groupByParams := buildGroupByClause(som.GroupBy())
+	lockClause, err := buildLockClause(som.Locks())
+	if err != nil {
+		return "", nil, err
+	}
+
+	groupByClause, err := buildGroupByClause(som.GroupBy())
+	if err != nil {
+		return "", nil, err
+	}
+
+	havingClause, havingParams, err := buildHavingClause(som.Havings())
+	if err != nil {
+		return "", nil, err
+	}
 
-	q = q + whereClause + orderClause + groupByClause + limitClause + offsetClause
+	q = q + joinClause + whereClause + groupByClause + havingClause + orderClause + limitClause + offsetClause + lockClause
 
-	params := append(whereParams, limitParams...)
+	params := append(withParams, exprParams...)
+	params = append(params, joinParams...)
+	params = append(params, whereParams...)
+	params = append(params, havingParams...)
+	params = append(params, orderParams...)
+	params = append(params, limitParams...)
 	params = append(params, offsetParams...)
-	params = append(params, groupByParams...)
 
-	var rows *sql.Rows
-	if len(params) > 0 {
-		stmt, err := b.db.PrepareContext(ctx, q)
-		if err != nil {
-			return err
-		}
+	return q, params, nil
+}
 
-		rows, err = stmt.QueryContext(ctx, params...)
-		if err != nil {
-			return err
-		}
-	} else {
-		rows, err = b.db.QueryContext(ctx, q)
-		if err != nil {
-			return err
-		}
+func (b *Belvedere) query(ctx context.Context, q string, params []interface{}) (*sql.Rows, error) {
+	return b.conn(ctx).QueryContext(ctx, b.dialect.Rebind(q), params...)
+}
+
+// fieldAddrs returns pointers to the fields of v in column order.
+func fieldAddrs(v reflect.Value, colToFieldIndex [][]int) []interface{} {
+	dest := make([]interface{}, len(colToFieldIndex))
+	for x, index := range colToFieldIndex {
+		dest[x] = v.FieldByIndex(index).Addr().Interface()
+	}
+
+	return dest
+}
+
+// sliceElemType returns the struct type of the elements of the slice dst
+// points to, and whether the elements are pointers.
+func sliceElemType(dst interface{}) (reflect.Type, bool, error) {
+	t, err := toSliceType(dst)
+	if err != nil {
+		return nil, false, err
+	}
+	if t == nil {
+		return nil, false, fmt.Errorf("belvedere: cannot SELECT into a non-slice: %v", reflect.TypeOf(dst))
+	}
+
+	isPtr := t.Kind() == reflect.Ptr
+	if isPtr {
+		t = t.Elem()
+	}
+
+	return t, isPtr, nil
+}
+
+// scanRows appends every row of rows to the slice dst points to.
+func scanRows(rows *sql.Rows, dst interface{}) error {
+	t, isPtr, err := sliceElemType(dst)
+	if err != nil {
+		return err
 	}
 
 	cols, err := rows.Columns()
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
 
 	var colToFieldIndex [][]int
 	colToFieldIndex, err = columnToFieldIndex(t, cols)
@@ -301,24 +492,9 @@ func (b *Belvedere) Select(ctx context.Context, dst interface{}, options ...NewS
 	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
 
 	for rows.Next() {
-		if rows.Err() != nil {
-			return rows.Err()
-		}
-
 		v := reflect.New(t)
 
-		dest := make([]interface{}, len(cols))
-		for x := range cols {
-			f := v.Elem()
-
-			index := colToFieldIndex[x]
-			f = f.FieldByIndex(index)
-			target := f.Addr().Interface()
-
-			dest[x] = target
-		}
-
-		err = rows.Scan(dest...)
+		err = rows.Scan(fieldAddrs(v.Elem(), colToFieldIndex)...)
 		if err != nil {
 			return err
 		}
@@ -329,6 +505,10 @@ func (b *Belvedere) Select(ctx context.Context, dst interface{}, options ...NewS
 		sliceValue.Set(reflect.Append(sliceValue, v))
 	}
 
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
 	if sliceValue.IsNil() {
 		sliceValue.Set(reflect.MakeSlice(sliceValue.Type(), 0, 0))
 	}
@@ -336,37 +516,47 @@ func (b *Belvedere) Select(ctx context.Context, dst interface{}, options ...NewS
 	return nil
 }
 
-func (b *Belvedere) Count(ctx context.Context, fn string, dst interface{}, options ...NewSelectOption) (int, error) {
-	tableInfo := newTableInfo(dst)
-	q := fmt.Sprintf("SELECT COUNT(%s) AS `cnt` FROM %s", fn, tableInfo.Name)
-	som := newSelectOptionMap(options...)
-	whereClause, whereParams, err := buildWhereClause(som.Wheres())
+func (b *Belvedere) selectInto(ctx context.Context, dst interface{}, t reflect.Type, som SelectOptionMap) error {
+	q, params, err := b.buildSelectQuery(t, som)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	q = q + whereClause
-
-	stmt, e := b.db.PrepareContext(ctx, q)
-	if e != nil {
-		return 0, e
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return err
 	}
+	defer rows.Close()
 
-	rows, e := stmt.QueryContext(ctx, whereParams...)
-	if e != nil {
-		return 0, e
+	return scanRows(rows, dst)
+}
+
+func (b *Belvedere) Select(ctx context.Context, dst interface{}, options ...NewSelectOption) error {
+	t, _, err := sliceElemType(dst)
+	if err != nil {
+		return err
 	}
 
-	defer rows.Close()
+	som := b.selectOptionMap(options...)
+	if err := checkLock(ctx, som); err != nil {
+		return err
+	}
 
-	var cnt int
-	for rows.Next() {
-		if e = rows.Scan(&cnt); e != nil {
-			return 0, e
-		}
+	if p := som.Paginate(); p != nil {
+		err = b.paginate(ctx, dst, t, som, p.(*paginate))
+	} else {
+		err = b.selectInto(ctx, dst, t, som)
+	}
+	if err != nil {
+		return err
 	}
 
-	return cnt, nil
+	return b.preload(ctx, sliceElems(dst), som.Preloads())
+}
+
+func (b *Belvedere) Count(ctx context.Context, fn string, dst interface{}, options ...NewSelectOption) (int, error) {
+	tableInfo := newTableInfo(dst)
+	return b.count(ctx, fn, tableInfo.Name, b.selectOptionMap(options...))
 }
 
 func NewBelvedere(driver, dataSorceName string) (QueryBuilder, error) {
@@ -380,5 +570,5 @@ func NewBelvedere(driver, dataSorceName string) (QueryBuilder, error) {
 		return nil, e
 	}
 
-	return &Belvedere{db: db}, nil
+	return &Belvedere{db: db, dialect: dialectFor(driver)}, nil
 }
@@ -2,6 +2,8 @@ package belvedere
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -120,6 +122,35 @@ func TestTableInfo_ColumnNames(t *testing.T) {
 	}
 }
 
+func TestTableInfo_ReadOnly(t *testing.T) {
+	type UserStat struct {
+		ID        uint64 `pk:"true"`
+		Name      string
+		PostCount int `readonly:"true"`
+	}
+
+	tableInfo := newTableInfo(&UserStat{ID: 1, Name: "foo", PostCount: 3})
+	if cnames := tableInfo.ColumnNames(false); cnames != "id,name" {
+		t.Errorf("The column names is not the value you expected expected: %s current value: %s", "id,name", cnames)
+	}
+
+	values, e := tableInfo.Values(false)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(values, []interface{}{uint64(1), "foo"}) {
+		t.Errorf("The column values is not the value you expected expected: %v current value: %v", []interface{}{uint64(1), "foo"}, values)
+	}
+
+	colToFieldIndex, e := columnToFieldIndex(reflect.TypeOf(UserStat{}), []string{"id", "name", "post_count"})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(colToFieldIndex[2], []int{2}) {
+		t.Errorf("The field index is not the value you expected expected: %v current value: %v", []int{2}, colToFieldIndex[2])
+	}
+}
+
 func TestBelvedere_SelectOne(t *testing.T) {
 	ctx, _ := context.WithCancel(context.Background())
 	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
@@ -216,6 +247,242 @@ func TestBelvedere_Count(t *testing.T) {
 	t.Log(cnt)
 }
 
+func TestBelvedere_Rows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	cur, e := b.Rows(ctx, &User{}, Order("id", OrderTypeAsc), Limit(2))
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer cur.Close()
+
+	for cur.Next() {
+		var u User
+		if e := cur.Scan(&u); e != nil {
+			t.Error(e)
+		}
+		t.Log(u.Name)
+	}
+
+	if e := cur.Err(); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestBelvedere_FindInBatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var users []*User
+	e = b.FindInBatches(ctx, &users, 2, func(batch int) error {
+		for _, u := range users {
+			t.Log(batch, u.ID)
+		}
+		return nil
+	})
+	if e != nil {
+		t.Error(e)
+	}
+}
+
+func TestBelvedere_Page(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var users []*User
+	p, e := b.Page(ctx, &users, 1, 2, Order("id", OrderTypeAsc))
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if len(users) > p.PerPage {
+		t.Errorf("The page size is not the value you expected expected: %d current value: %d", p.PerPage, len(users))
+	}
+	t.Log(p.Total, p.TotalPages, p.HasNext)
+	// The slice of the first page is reused for the second one.
+	p, e = b.Page(ctx, &users, 2, 2, Order("id", OrderTypeAsc))
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if len(users) > p.PerPage {
+		t.Errorf("The page size is not the value you expected expected: %d current value: %d", p.PerPage, len(users))
+	}
+}
+
+func TestBelvedere_Sum(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var sum sql.NullInt64
+	if e := b.Sum(ctx, "id", &User{}, &sum); e != nil {
+		t.Error(e)
+	}
+	t.Log(sum)
+
+	var empty sql.NullString
+	if e := b.Sum(ctx, "id", &User{}, &empty, Lt("id", 0)); e != nil || empty.Valid {
+		t.Errorf("The sum of an empty set is not NULL: %v %v", empty, e)
+	}
+
+	if e := b.Sum(ctx, "id) FROM user; --", &User{}, &sum); e != ErrInvalidIdentifier {
+		t.Errorf("The error is not the value you expected expected: %v current value: %v", ErrInvalidIdentifier, e)
+	}
+}
+
+func TestBelvedere_Exists(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	exists, e := b.Exists(ctx, &User{}, Eq("id", 1))
+	if e != nil {
+		t.Error(e)
+	}
+
+	t.Log(exists)
+}
+
+func TestBelvedere_Pluck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var ids []uint64
+	e = b.Pluck(ctx, &User{}, "id", &ids, Order("id", OrderTypeAsc), Limit(2))
+	if e != nil {
+		t.Error(e)
+	}
+
+	t.Log(ids)
+}
+
+func TestBelvedere_Raw(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var users []*User
+	e = b.Raw(ctx, &users, "SELECT * FROM user WHERE id > ? ORDER BY id LIMIT 2", 0)
+	if e != nil {
+		t.Error(e)
+	}
+
+	for _, u := range users {
+		t.Log(u.Name)
+	}
+
+	u := &User{}
+	e = b.RawOne(ctx, u, "SELECT * FROM user WHERE id = ?", 1)
+	if e != nil {
+		t.Error(e)
+	}
+
+	t.Log(u.Name)
+}
+
+func TestBelvedere_SelectUnion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var users []*User
+	u := UnionAll(Sub(&User{}, Eq("id", 1)), Sub(&User{}, Gt("id", 1)))
+	e = b.SelectUnion(ctx, &users, u, Order("id", OrderTypeAsc), Limit(2))
+	if e != nil {
+		t.Error(e)
+	}
+
+	for _, u := range users {
+		t.Log(u.Name)
+	}
+}
+
+func TestBelvedere_Transaction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	before, e := b.Count(ctx, "*", &User{})
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	rollback := errors.New("rollback")
+	e = b.Transaction(ctx, func(ctx context.Context) error {
+		if _, e := b.Insert(ctx, &User{Name: "foo", CreatedAt: nowTime(), UpdatedAt: nowTime()}); e != nil {
+			return e
+		}
+		return rollback
+	})
+	if e != rollback {
+		t.Errorf("The error is not the value you expected expected: %v current value: %v", rollback, e)
+	}
+
+	after, e := b.Count(ctx, "*", &User{})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if after != before {
+		t.Errorf("The count is not the value you expected expected: %d current value: %d", before, after)
+	}
+}
+
+func TestBelvedere_First(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b, e := NewBelvedere("mysql", "root:@/test?parseTime=true")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	u := &User{}
+	if e := b.First(ctx, u, ForUpdate()); e != ErrNotInTransaction {
+		t.Errorf("The error is not the value you expected expected: %v current value: %v", ErrNotInTransaction, e)
+	}
+
+	e = b.Transaction(ctx, func(ctx context.Context) error {
+		return b.First(ctx, u, Gt("id", 0), ForUpdate(), SkipLocked())
+	})
+	if e != nil {
+		t.Error(e)
+	}
+
+	t.Log(u.Name)
+}
+
 func TestBelvedere_Insert(t *testing.T) {
 	mockNow := nowTime()
 	data := []struct {
@@ -0,0 +1,163 @@
+package belvedere
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02",
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// scanTypeName returns the database type equivalent to the scan type of a
+// column, for drivers that report no database type name.
+func scanTypeName(scanType reflect.Type) string {
+	if scanType == nil {
+		return ""
+	}
+
+	// Nullable types such as sql.NullInt64 wrap the value in their first
+	// field.
+	if scanType.Kind() == reflect.Struct && scanType != timeType && scanType.NumField() > 0 {
+		scanType = scanType.Field(0).Type
+	}
+
+	switch scanType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "BIGINT"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "UNSIGNED BIGINT"
+	case reflect.Float32, reflect.Float64:
+		return "DOUBLE"
+	}
+	if scanType == timeType {
+		return "DATETIME"
+	}
+
+	return ""
+}
+
+// normalizeValue converts a driver value into a plain Go value: []byte
+// becomes a string, or a number or time.Time when the database type of the
+// column, or else its scan type, says so. Values the driver already
+// converted are kept.
+func normalizeValue(databaseType string, scanType reflect.Type, value interface{}) interface{} {
+	raw, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+
+	if databaseType == "" {
+		databaseType = scanTypeName(scanType)
+	}
+
+	s := string(raw)
+	switch strings.ToUpper(databaseType) {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "YEAR",
+		"INT2", "INT4", "INT8":
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return u
+		}
+	case "UNSIGNED TINYINT", "UNSIGNED SMALLINT", "UNSIGNED MEDIUMINT", "UNSIGNED INT", "UNSIGNED BIGINT":
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return u
+		}
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8":
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	case "DATE", "DATETIME", "TIMESTAMP", "TIMESTAMPTZ":
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+				return t
+			}
+		}
+	}
+
+	return s
+}
+
+// SelectMaps selects rows of table into maps keyed by column name, for
+// tables that have no struct. The query is always prepared, so that drivers
+// which report no column types, such as go-sql-driver/mysql before 1.4, still
+// return numbers (and times with parseTime) in their binary protocol.
+func (b *Belvedere) SelectMaps(ctx context.Context, table string, options ...NewSelectOption) ([]map[string]interface{}, error) {
+	if err := validateIdentifier(table); err != nil {
+		return nil, err
+	}
+
+	som := b.selectOptionMap(options...)
+	err := som.only(
+		selectOptionTypeWith,
+		selectOptionTypeDistinct,
+		selectOptionTypeExpr,
+		selectOptionTypeJoin,
+		selectOptionTypeWhere,
+		selectOptionTypeGroupBy,
+		selectOptionTypeHaving,
+		selectOptionTypeOrder,
+		selectOptionTypeLimit,
+		selectOptionTypeOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selectList := "*"
+	if len(som.SelectExprs()) > 0 {
+		selectList = b.dialect.Quote(table + ".*")
+	}
+
+	q, params, err := b.buildQuery(selectList, b.from(table, ""), som)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := b.conn(ctx).PrepareContext(ctx, b.dialect.Rebind(q))
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(columnTypes))
+		dest := make([]interface{}, len(columnTypes))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]interface{}, len(columnTypes))
+		for i, ct := range columnTypes {
+			m[ct.Name()] = normalizeValue(ct.DatabaseTypeName(), ct.ScanType(), values[i])
+		}
+		results = append(results, m)
+	}
+
+	return results, rows.Err()
+}
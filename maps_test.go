@@ -0,0 +1,111 @@
+package belvedere
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalizeValue(t *testing.T) {
+	tests := []struct {
+		name         string
+		databaseType string
+		scanType     reflect.Type
+		in           interface{}
+		want         interface{}
+	}{
+		{
+			name:         "text",
+			databaseType: "VARCHAR",
+			in:           []byte("foo"),
+			want:         "foo",
+		},
+		{
+			name:         "integer",
+			databaseType: "BIGINT",
+			in:           []byte("-12"),
+			want:         int64(-12),
+		},
+		{
+			name:         "unsigned integer",
+			databaseType: "UNSIGNED BIGINT",
+			in:           []byte("18446744073709551615"),
+			want:         uint64(18446744073709551615),
+		},
+		{
+			name:         "double",
+			databaseType: "DOUBLE",
+			in:           []byte("1.5"),
+			want:         1.5,
+		},
+		{
+			name:         "decimal stays exact",
+			databaseType: "DECIMAL",
+			in:           []byte("10.10"),
+			want:         "10.10",
+		},
+		{
+			name:         "datetime",
+			databaseType: "DATETIME",
+			in:           []byte("2010-01-01 00:00:00"),
+			want:         nowTime(),
+		},
+		{
+			name:         "already converted",
+			databaseType: "INT",
+			in:           int64(1),
+			want:         int64(1),
+		},
+		{
+			name:     "integer by scan type",
+			scanType: reflect.TypeOf(int64(0)),
+			in:       []byte("12"),
+			want:     int64(12),
+		},
+		{
+			name:     "nullable float by scan type",
+			scanType: reflect.TypeOf(sql.NullFloat64{}),
+			in:       []byte("1.5"),
+			want:     1.5,
+		},
+		{
+			name:     "datetime by scan type",
+			scanType: reflect.TypeOf(time.Time{}),
+			in:       []byte("2010-01-01 00:00:00"),
+			want:     nowTime(),
+		},
+		{
+			name:     "unknown type keeps converted values",
+			scanType: reflect.TypeOf(new(interface{})).Elem(),
+			in:       int64(1),
+			want:     int64(1),
+		},
+		{
+			name:     "unknown type is text",
+			scanType: reflect.TypeOf(new(interface{})).Elem(),
+			in:       []byte("01234"),
+			want:     "01234",
+		},
+		{
+			name: "no type information",
+			in:   []byte("foo"),
+			want: "foo",
+		},
+		{
+			name:         "null",
+			databaseType: "VARCHAR",
+			in:           nil,
+			want:         nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := normalizeValue(tt.databaseType, tt.scanType, tt.in)
+			if !reflect.DeepEqual(v, tt.want) {
+				t.Errorf("normalizeValue() result: %#v expected value: %#v", v, tt.want)
+			}
+		})
+	}
+}
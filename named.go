@@ -0,0 +1,142 @@
+package belvedere
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// namedArg returns the single struct or map with string keys args holds
+// when query uses named parameters. Otherwise the argument is bound
+// positionally.
+func namedArg(query string, args []interface{}) (interface{}, bool) {
+	if len(args) != 1 || !hasNamed(query) {
+		return nil, false
+	}
+
+	arg := args[0]
+	switch arg.(type) {
+	case time.Time, *time.Time, driver.Valuer:
+		return nil, false
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(arg))
+	switch v.Kind() {
+	case reflect.Struct:
+		return arg, true
+	case reflect.Map:
+		return arg, v.Type().Key().Kind() == reflect.String
+	}
+
+	return nil, false
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || ('0' <= c && c <= '9')
+}
+
+// namedValue looks name up in a map with string keys or in the fields of a
+// struct, whose names follow the column naming rules. Unexported fields
+// cannot be read, so naming one is an error.
+func namedValue(arg interface{}, name string) (interface{}, error) {
+	v := reflect.Indirect(reflect.ValueOf(arg))
+	if v.Kind() == reflect.Map {
+		value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !value.IsValid() {
+			return nil, fmt.Errorf("belvedere: no value for named parameter %q", name)
+		}
+		return value.Interface(), nil
+	}
+
+	columnName := strings.ToLower(name)
+	f, ok := v.Type().FieldByNameFunc(func(fieldName string) bool {
+		return fieldName == name || camelToSnake(fieldName) == columnName
+	})
+	if !ok {
+		return nil, fmt.Errorf("belvedere: no value for named parameter %q", name)
+	}
+	if f.PkgPath != "" {
+		return nil, ErrUnexportedField
+	}
+
+	return v.FieldByIndex(f.Index).Interface(), nil
+}
+
+// namedPlaceholder returns the index just after the `:name` or `@name`
+// placeholder starting at query[i], or i if there is none. `::` casts and
+// `@@` system variables are not placeholders.
+func namedPlaceholder(query string, i int) int {
+	c := query[i]
+	if c != ':' && c != '@' {
+		return i
+	}
+	if i+1 >= len(query) || !isNameStart(query[i+1]) || (i > 0 && (query[i-1] == c || isNameChar(query[i-1]))) {
+		return i
+	}
+
+	j := i + 1
+	for j < len(query) && isNameChar(query[j]) {
+		j++
+	}
+
+	return j
+}
+
+// hasNamed reports whether query has a named placeholder outside of quoted
+// strings.
+func hasNamed(query string) bool {
+	for i := 0; i < len(query); i++ {
+		if j := skipQuoted(query, i); j > i {
+			i = j - 1
+			continue
+		}
+		if namedPlaceholder(query, i) > i {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bindNamed rewrites the `:name` and `@name` placeholders of query into `?`
+// and returns their values in order. Placeholders inside quoted strings,
+// `::` casts and `@@` system variables are left alone. A `?` placeholder
+// would have no value, so it is an error.
+func bindNamed(query string, arg interface{}) (string, []interface{}, error) {
+	var buf strings.Builder
+	var args []interface{}
+	for i := 0; i < len(query); {
+		if j := skipQuoted(query, i); j > i {
+			buf.WriteString(query[i:j])
+			i = j
+			continue
+		}
+
+		if query[i] == '?' {
+			return "", nil, fmt.Errorf("belvedere: unbound positional placeholder in a query with named parameters")
+		}
+
+		if j := namedPlaceholder(query, i); j > i {
+			value, err := namedValue(arg, query[i+1:j])
+			if err != nil {
+				return "", nil, err
+			}
+
+			buf.WriteByte('?')
+			args = append(args, value)
+			i = j
+			continue
+		}
+
+		buf.WriteByte(query[i])
+		i++
+	}
+
+	return buf.String(), args, nil
+}
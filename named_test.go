@@ -0,0 +1,157 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBindNamed(t *testing.T) {
+	type Filter struct {
+		Gendor   string
+		MinAge   int
+		LastName string
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		arg    interface{}
+		want   string
+		params []interface{}
+	}{
+		{
+			name:   "bind from map",
+			query:  "age > :age AND gendor = @gendor",
+			arg:    map[string]interface{}{"age": 20, "gendor": "male"},
+			want:   "age > ? AND gendor = ?",
+			params: []interface{}{20, "male"},
+		},
+		{
+			name:   "bind from struct with column names",
+			query:  "age >= :min_age AND (gendor = :gendor OR last_name = :LastName)",
+			arg:    &Filter{Gendor: "male", MinAge: 20, LastName: "foo"},
+			want:   "age >= ? AND (gendor = ? OR last_name = ?)",
+			params: []interface{}{20, "male", "foo"},
+		},
+		{
+			name:   "bind from map of another value type",
+			query:  "age > :age",
+			arg:    map[string]int{"age": 20},
+			want:   "age > ?",
+			params: []interface{}{20},
+		},
+		{
+			name:   "reuse a parameter",
+			query:  "created_at >= :day AND updated_at >= :day",
+			arg:    map[string]interface{}{"day": "2018-01-01"},
+			want:   "created_at >= ? AND updated_at >= ?",
+			params: []interface{}{"2018-01-01", "2018-01-01"},
+		},
+		{
+			name:   "ignore quoted strings, casts and system variables",
+			query:  "name = ':name' AND id::text = :id AND @@autocommit = 1",
+			arg:    map[string]interface{}{"id": 1},
+			want:   "name = ':name' AND id::text = ? AND @@autocommit = 1",
+			params: []interface{}{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, p, e := bindNamed(tt.query, tt.arg)
+			if q != tt.want {
+				t.Errorf("bindNamed() result: %s expected value: %s", q, tt.want)
+			}
+			if !reflect.DeepEqual(p, tt.params) {
+				t.Errorf("bindNamed() params: %v expected value: %v", p, tt.params)
+			}
+			if e != nil {
+				t.Errorf("bindNamed() err: %s", e)
+			}
+		})
+	}
+}
+
+func TestBindNamed_Missing(t *testing.T) {
+	_, _, e := bindNamed("age > :age", map[string]interface{}{})
+	if e == nil {
+		t.Errorf("bindNamed() should fail when a parameter has no value")
+	}
+}
+
+func TestWhere_Named(t *testing.T) {
+	w := Where("age > :age", map[string]interface{}{"age": 20})()
+	q, e := w.Conditions()
+	if q != "age > ?" || e != nil {
+		t.Errorf("where.Conditions() result: %s, %v expected value: %s", q, e, "age > ?")
+	}
+	if !reflect.DeepEqual(w.Params(), []interface{}{20}) {
+		t.Errorf("where.Params() result: %v expected value: %v", w.Params(), []interface{}{20})
+	}
+
+	w = Where("created_at > ?", nowTime())()
+	if q, _ := w.Conditions(); q != "created_at > ?" {
+		t.Errorf("where.Conditions() should not bind a time.Time by name: %s", q)
+	}
+
+	w = Where("name = :name", map[string]string{"name": "foo"})()
+	q, e = w.Conditions()
+	if q != "name = ?" || e != nil {
+		t.Errorf("where.Conditions() result: %s, %v expected value: %s", q, e, "name = ?")
+	}
+	if !reflect.DeepEqual(w.Params(), []interface{}{"foo"}) {
+		t.Errorf("where.Params() result: %v expected value: %v", w.Params(), []interface{}{"foo"})
+	}
+
+	w = Where("name = :secret", struct{ secret string }{"x"})()
+	if _, e := w.Conditions(); e != ErrUnexportedField {
+		t.Errorf("where.Conditions() err: %v expected value: %v", e, ErrUnexportedField)
+	}
+}
+
+func TestWhere_PositionalStruct(t *testing.T) {
+	type Payload struct {
+		A int
+	}
+
+	w := Where("data = ?", Payload{A: 1})()
+	q, e := w.Conditions()
+	if q != "data = ?" || e != nil {
+		t.Errorf("where.Conditions() result: %s, %v expected value: %s", q, e, "data = ?")
+	}
+	if !reflect.DeepEqual(w.Params(), []interface{}{Payload{A: 1}}) {
+		t.Errorf("where.Params() result: %v expected value: %v", w.Params(), []interface{}{Payload{A: 1}})
+	}
+
+	w = Where("data = ':a'", map[string]interface{}{"a": 1})()
+	if !reflect.DeepEqual(w.Params(), []interface{}{map[string]interface{}{"a": 1}}) {
+		t.Errorf("where.Params() should not bind placeholders in quoted strings: %v", w.Params())
+	}
+}
+
+func TestBindNamed_Positional(t *testing.T) {
+	_, _, e := bindNamed("age > :age AND gendor = ?", map[string]interface{}{"age": 20})
+	if e == nil {
+		t.Errorf("bindNamed() should fail when a positional placeholder is left unbound")
+	}
+
+	w := Where("age > :age AND gendor = ?", map[string]interface{}{"age": 20})()
+	if _, e := w.Conditions(); e == nil {
+		t.Errorf("where.Conditions() should fail when a positional placeholder is left unbound")
+	}
+}
+
+func TestRawQuery_PositionalStruct(t *testing.T) {
+	type Payload struct {
+		A int
+	}
+
+	b := &Belvedere{dialect: mysqlDialect{}}
+	q, p, e := b.rawQuery("SELECT * FROM user WHERE data = ?", []interface{}{Payload{A: 1}})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if q != "SELECT * FROM user WHERE data = ?" || !reflect.DeepEqual(p, []interface{}{Payload{A: 1}}) {
+		t.Errorf("rawQuery() result: %s %v expected value: %s %v", q, p, "SELECT * FROM user WHERE data = ?", []interface{}{Payload{A: 1}})
+	}
+}
@@ -0,0 +1,339 @@
+package belvedere
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+type (
+	// PageInfo receives the cursors of a page selected with Paginate.
+	PageInfo struct {
+		NextCursor string
+		PrevCursor string
+		HasNext    bool
+		HasPrev    bool
+	}
+
+	// Pagination describes a page selected with Page.
+	Pagination struct {
+		Page       int
+		PerPage    int
+		Total      int
+		TotalPages int
+		HasNext    bool
+	}
+
+	paginate struct {
+		token string
+		size  int
+		info  *PageInfo
+	}
+
+	// keysetKey is a column the page is sorted by and the struct field that
+	// holds its value.
+	keysetKey struct {
+		column string
+		oType  OrderType
+		index  []int
+	}
+
+	pageToken struct {
+		Prev   bool              `json:"p,omitempty"`
+		Values []json.RawMessage `json:"v"`
+	}
+)
+
+func (o OrderType) reverse() OrderType {
+	if o == OrderTypeDesc {
+		return OrderTypeAsc
+	}
+
+	return OrderTypeDesc
+}
+
+// paginate
+func (p *paginate) Conditions() (string, error) {
+	return "", nil
+}
+
+func (p *paginate) Params() []interface{} {
+	return []interface{}{}
+}
+
+func (p *paginate) Type() SelectOptionType {
+	return selectOptionTypePaginate
+}
+
+// keysetKeys returns the sort keys of a page: the Order columns followed by
+// the primary key, which makes the order total.
+func keysetKeys(t reflect.Type, orders []SelectOption) ([]keysetKey, error) {
+	ti := newTableInfo(reflect.New(t).Interface())
+	if ti.Pk.Name == "" {
+		return nil, ErrNoPrimaryKey
+	}
+
+	var keys []keysetKey
+	hasPk := false
+	for _, option := range orders {
+		o, ok := option.(*order)
+		if !ok || o.nulls != NullsDefault {
+			return nil, ErrUnsupportedOption
+		}
+		if err := validateIdentifier(o.conditions); err != nil {
+			return nil, err
+		}
+
+		name := o.conditions[strings.LastIndex(o.conditions, ".")+1:]
+		colToFieldIndex, err := columnToFieldIndex(t, []string{name})
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, keysetKey{
+			column: o.conditions,
+			oType:  o.oType,
+			index:  colToFieldIndex[0],
+		})
+		if ti.Pk.SameName(name) {
+			hasPk = true
+		}
+	}
+
+	if !hasPk {
+		keys = append(keys, keysetKey{
+			column: ti.Pk.Name,
+			oType:  OrderTypeAsc,
+			index:  []int{ti.Pk.Index},
+		})
+	}
+
+	return keys, nil
+}
+
+// keysetCondition selects the rows after values in the order of keys, or
+// before them when reverse is set.
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ...
+func keysetCondition(keys []keysetKey, values []interface{}, reverse bool) NewSelectOption {
+	ors := make([]NewSelectOption, len(keys))
+	for i, key := range keys {
+		ands := make([]NewSelectOption, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, Eq(keys[j].column, values[j]))
+		}
+
+		if (key.oType == OrderTypeAsc) != reverse {
+			ands = append(ands, Gt(key.column, values[i]))
+		} else {
+			ands = append(ands, Lt(key.column, values[i]))
+		}
+		ors[i] = And(ands...)
+	}
+
+	return Or(ors...)
+}
+
+func encodePageToken(v reflect.Value, keys []keysetKey, prev bool) (string, error) {
+	v = reflect.Indirect(v)
+	token := pageToken{Prev: prev}
+	for _, key := range keys {
+		value, err := json.Marshal(v.FieldByIndex(key.index).Interface())
+		if err != nil {
+			return "", err
+		}
+		token.Values = append(token.Values, value)
+	}
+
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePageToken decodes the key values of a token into the types of the
+// struct fields they belong to.
+func decodePageToken(s string, t reflect.Type, keys []keysetKey) ([]interface{}, bool, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, false, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, false, ErrInvalidPageToken
+	}
+	if len(token.Values) != len(keys) {
+		return nil, false, ErrInvalidPageToken
+	}
+
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		v := reflect.New(t.FieldByIndex(key.index).Type)
+		if err := json.Unmarshal(token.Values[i], v.Interface()); err != nil {
+			return nil, false, ErrInvalidPageToken
+		}
+		values[i] = v.Elem().Interface()
+	}
+
+	return values, token.Prev, nil
+}
+
+func (b *Belvedere) paginate(ctx context.Context, dst interface{}, t reflect.Type, som SelectOptionMap, p *paginate) error {
+	if p.size <= 0 {
+		return errors.New("page size must be greater than zero")
+	}
+	if som.Limit() != nil || som.Offset() != nil {
+		return ErrUnsupportedOption
+	}
+
+	keys, err := keysetKeys(t, som.Orders())
+	if err != nil {
+		return err
+	}
+
+	var values []interface{}
+	reverse := false
+	if p.token != "" {
+		values, reverse, err = decodePageToken(p.token, t, keys)
+		if err != nil {
+			return err
+		}
+	}
+
+	var options []NewSelectOption
+	if values != nil {
+		options = append(options, keysetCondition(keys, values, reverse))
+	}
+	for _, key := range keys {
+		oType := key.oType
+		if reverse {
+			oType = oType.reverse()
+		}
+		options = append(options, Order(key.column, oType))
+	}
+	// One extra row tells whether there is another page.
+	options = append(options, Limit(p.size+1))
+
+	pageSom := SelectOptionMap{}
+	for k, v := range som {
+		if k != selectOptionTypeOrder && k != selectOptionTypePaginate {
+			pageSom[k] = append([]SelectOption{}, v...)
+		}
+	}
+	for k, v := range newSelectOptionMap(options...) {
+		pageSom[k] = append(pageSom[k], v...)
+	}
+	pageSom.setDialect(b.dialect)
+
+	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
+	sliceValue.Set(reflect.Zero(sliceValue.Type()))
+	if err := b.selectInto(ctx, dst, t, pageSom); err != nil {
+		return err
+	}
+
+	hasMore := sliceValue.Len() > p.size
+	if hasMore {
+		sliceValue.Set(sliceValue.Slice(0, p.size))
+	}
+
+	n := sliceValue.Len()
+	if reverse {
+		swap := reflect.Swapper(sliceValue.Interface())
+		for i := 0; i < n/2; i++ {
+			swap(i, n-1-i)
+		}
+	}
+
+	info := PageInfo{}
+	if reverse {
+		info.HasPrev = hasMore
+		info.HasNext = true
+	} else {
+		info.HasNext = hasMore
+		info.HasPrev = p.token != ""
+	}
+
+	if n > 0 && info.HasNext {
+		if info.NextCursor, err = encodePageToken(sliceValue.Index(n-1), keys, false); err != nil {
+			return err
+		}
+	}
+	if n > 0 && info.HasPrev {
+		if info.PrevCursor, err = encodePageToken(sliceValue.Index(0), keys, true); err != nil {
+			return err
+		}
+	}
+
+	if p.info != nil {
+		*p.info = info
+	}
+
+	return nil
+}
+
+// Paginate selects one page of size rows after the position encoded in
+// token, using the Order columns and the primary key as the keyset. An empty
+// token selects the first page. The cursors of the neighbouring pages are
+// stored in info.
+func Paginate(token string, size int, info *PageInfo) NewSelectOption {
+	return func() SelectOption {
+		return &paginate{
+			token: token,
+			size:  size,
+			info:  info,
+		}
+	}
+}
+
+// Page selects the page-th page (starting at 1) of perPage rows into dst and
+// counts the rows matching the where options of options.
+func (b *Belvedere) Page(ctx context.Context, dst interface{}, page, perPage int, options ...NewSelectOption) (*Pagination, error) {
+	if page < 1 || perPage < 1 {
+		return nil, errors.New("page and per page must be greater than zero")
+	}
+
+	t, _, err := sliceElemType(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	som := b.selectOptionMap(options...)
+	if som.Limit() != nil || som.Offset() != nil || som.Paginate() != nil {
+		return nil, ErrUnsupportedOption
+	}
+
+	countSom := SelectOptionMap{
+		selectOptionTypeWith:  som.Withs(),
+		selectOptionTypeJoin:  som.Joins(),
+		selectOptionTypeWhere: som.Wheres(),
+	}
+	total, err := b.count(ctx, "*", getTableNameFromTypeName(t), countSom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select appends, so rows of a previous page must not be kept.
+	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
+	sliceValue.Set(reflect.Zero(sliceValue.Type()))
+
+	pageOptions := append([]NewSelectOption{}, options...)
+	pageOptions = append(pageOptions, Limit(perPage), Offset(uint((page-1)*perPage)))
+	if err := b.Select(ctx, dst, pageOptions...); err != nil {
+		return nil, err
+	}
+
+	totalPages := (total + perPage - 1) / perPage
+
+	return &Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	keys := []keysetKey{
+		{column: "created_at", oType: OrderTypeDesc},
+		{column: "id", oType: OrderTypeAsc},
+	}
+	values := []interface{}{nowTime(), uint64(10)}
+
+	tests := []struct {
+		name    string
+		reverse bool
+		want    string
+	}{
+		{
+			name:    "next page",
+			reverse: false,
+			want:    "((`created_at` < ?) OR (`created_at` = ? AND `id` > ?))",
+		},
+		{
+			name:    "previous page",
+			reverse: true,
+			want:    "((`created_at` > ?) OR (`created_at` = ? AND `id` < ?))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := keysetCondition(keys, values, tt.reverse)()
+			q, e := c.Conditions()
+			if q != tt.want {
+				t.Errorf("keysetCondition() result: %s expected value: %s", q, tt.want)
+			}
+			if e != nil {
+				t.Errorf("keysetCondition() err: %s", e)
+			}
+
+			want := []interface{}{nowTime(), nowTime(), uint64(10)}
+			if !reflect.DeepEqual(c.Params(), want) {
+				t.Errorf("keysetCondition() params: %v expected value: %v", c.Params(), want)
+			}
+		})
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	typ := reflect.TypeOf(User{})
+	keys, e := keysetKeys(typ, newSelectOptionMap(Order("created_at", OrderTypeDesc)).Orders())
+	if e != nil {
+		t.Fatal(e)
+	}
+	if len(keys) != 2 || keys[1].column != "id" {
+		t.Fatalf("keysetKeys() should append the primary key: %v", keys)
+	}
+
+	u := &User{ID: 10, CreatedAt: nowTime()}
+	token, e := encodePageToken(reflect.ValueOf(u), keys, true)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	values, prev, e := decodePageToken(token, typ, keys)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !prev {
+		t.Errorf("decodePageToken() should keep the direction")
+	}
+	if !values[0].(time.Time).Equal(u.CreatedAt) || values[1] != uint64(10) {
+		t.Errorf("decodePageToken() result: %v expected value: %v", values, []interface{}{u.CreatedAt, u.ID})
+	}
+
+	if _, _, e := decodePageToken("not a token", typ, keys); e != ErrInvalidPageToken {
+		t.Errorf("decodePageToken() err: %v expected value: %v", e, ErrInvalidPageToken)
+	}
+}
@@ -0,0 +1,71 @@
+package belvedere
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Pluck selects a single column of the table of model into dst, which must
+// be a pointer to a slice of a scalar type or of a type implementing
+// sql.Scanner.
+func (b *Belvedere) Pluck(ctx context.Context, model interface{}, column string, dst interface{}, options ...NewSelectOption) error {
+	if err := validateIdentifier(column); err != nil {
+		return err
+	}
+
+	t, err := toSliceType(dst)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return fmt.Errorf("belvedere: cannot pluck into a non-slice: %v", reflect.TypeOf(dst))
+	}
+
+	som := b.selectOptionMap(options...)
+	err = som.only(
+		selectOptionTypeWith,
+		selectOptionTypeDistinct,
+		selectOptionTypeJoin,
+		selectOptionTypeWhere,
+		selectOptionTypeGroupBy,
+		selectOptionTypeHaving,
+		selectOptionTypeOrder,
+		selectOptionTypeLimit,
+		selectOptionTypeOffset,
+	)
+	if err != nil {
+		return err
+	}
+
+	tableInfo := newTableInfo(model)
+	q, params, err := b.buildQuery(b.dialect.Quote(column), b.from(tableInfo.Name, ""), som)
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
+	for rows.Next() {
+		v := reflect.New(t)
+		if err = rows.Scan(v.Interface()); err != nil {
+			return err
+		}
+		sliceValue.Set(reflect.Append(sliceValue, v.Elem()))
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if sliceValue.IsNil() {
+		sliceValue.Set(reflect.MakeSlice(sliceValue.Type(), 0, 0))
+	}
+
+	return nil
+}
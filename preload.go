@@ -0,0 +1,427 @@
+package belvedere
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// relation is an association field declared with a `rel` tag, e.g.
+	//
+	//	Posts []*Post `rel:"has_many,fk=user_id"`
+	//	User  *User   `rel:"belongs_to,fk=user_id"`
+	//	Roles []*Role `rel:"many_to_many,join=user_roles,fk=user_id,ref=role_id"`
+	//
+	// For many_to_many, fk and ref are the columns of the join table that
+	// refer to the owner and the target.
+	relation struct {
+		name   string
+		kind   string
+		fk     string
+		join   string
+		ref    string
+		index  []int
+		target reflect.Type
+	}
+
+	preload struct {
+		path string
+	}
+)
+
+const (
+	relationHasMany    = "has_many"
+	relationBelongsTo  = "belongs_to"
+	relationManyToMany = "many_to_many"
+)
+
+// preload
+func (p *preload) Conditions() (string, error) {
+	return "", nil
+}
+
+func (p *preload) Params() []interface{} {
+	return []interface{}{}
+}
+
+func (p *preload) Type() SelectOptionType {
+	return selectOptionTypePreload
+}
+
+// parseRelation parses the `rel` tag of the field name of t. Without fk, the
+// foreign key defaults to `<owner>_id` for has_many and many_to_many and
+// `<field>_id` for belongs_to. Without ref, it defaults to `<target>_id`.
+func parseRelation(t reflect.Type, name string) (*relation, error) {
+	f, ok := t.FieldByName(name)
+	if !ok || f.Tag.Get("rel") == "" {
+		return nil, ErrUnknownField
+	}
+
+	parts := strings.Split(f.Tag.Get("rel"), ",")
+	r := &relation{
+		name:  name,
+		kind:  strings.TrimSpace(parts[0]),
+		index: f.Index,
+	}
+	for _, part := range parts[1:] {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "fk":
+			r.fk = kv[1]
+		case "join":
+			r.join = kv[1]
+		case "ref":
+			r.ref = kv[1]
+		}
+	}
+
+	target := f.Type
+	switch r.kind {
+	case relationHasMany, relationManyToMany:
+		if target.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("belvedere: %s relation %s must be a slice", r.kind, name)
+		}
+		if r.kind == relationManyToMany && r.join == "" {
+			return nil, fmt.Errorf("belvedere: %s relation %s needs a join table", r.kind, name)
+		}
+		target = target.Elem()
+		if r.fk == "" {
+			r.fk = getTableNameFromTypeName(t) + "_id"
+		}
+	case relationBelongsTo:
+		if r.fk == "" {
+			r.fk = camelToSnake(name) + "_id"
+		}
+	default:
+		return nil, fmt.Errorf("belvedere: unknown relation %q on %s", r.kind, name)
+	}
+
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+	if target.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("belvedere: relation %s must refer to a struct", name)
+	}
+	r.target = target
+	if r.kind == relationManyToMany && r.ref == "" {
+		r.ref = getTableNameFromTypeName(target) + "_id"
+	}
+
+	return r, nil
+}
+
+// sliceElems returns the structs of the slice dst points to.
+func sliceElems(dst interface{}) []reflect.Value {
+	sliceValue := reflect.Indirect(reflect.ValueOf(dst))
+	elems := make([]reflect.Value, 0, sliceValue.Len())
+	for i := 0; i < sliceValue.Len(); i++ {
+		elem := reflect.Indirect(sliceValue.Index(i))
+		if elem.IsValid() {
+			elems = append(elems, elem)
+		}
+	}
+
+	return elems
+}
+
+// keyValue returns the driver value of a key field, resolving nullable types,
+// and false if it is NULL.
+func keyValue(v reflect.Value) (interface{}, bool) {
+	value := v.Interface()
+	if valuer, ok := value.(driver.Valuer); ok {
+		var err error
+		if value, err = valuer.Value(); err != nil || value == nil {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// keyOf returns a map key for a key field, so that e.g. an int64 foreign key
+// matches a uint64 primary key.
+func keyOf(v reflect.Value) (string, bool) {
+	value, ok := keyValue(v)
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprint(value), true
+}
+
+// uniqueValues returns the distinct non-NULL values of the field index of
+// owners.
+func uniqueValues(owners []reflect.Value, index []int) []interface{} {
+	seen := map[string]bool{}
+	var values []interface{}
+	for _, owner := range owners {
+		f := owner.FieldByIndex(index)
+		key, ok := keyOf(f)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		value, _ := keyValue(f)
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// preload loads the associations named by preloads into owners. A path such
+// as `Posts.Comments` loads the comments of the loaded posts as well.
+func (b *Belvedere) preload(ctx context.Context, owners []reflect.Value, preloads []SelectOption) error {
+	if len(owners) == 0 || len(preloads) == 0 {
+		return nil
+	}
+
+	var names []string
+	nested := map[string][]NewSelectOption{}
+	for _, option := range preloads {
+		parts := strings.SplitN(option.(*preload).path, ".", 2)
+		if _, ok := nested[parts[0]]; !ok {
+			names = append(names, parts[0])
+			nested[parts[0]] = nil
+		}
+		if len(parts) == 2 {
+			nested[parts[0]] = append(nested[parts[0]], Preload(parts[1]))
+		}
+	}
+
+	t := owners[0].Type()
+	for _, name := range names {
+		r, err := parseRelation(t, name)
+		if err != nil {
+			return err
+		}
+
+		switch r.kind {
+		case relationHasMany:
+			err = b.preloadHasMany(ctx, owners, r, nested[name])
+		case relationBelongsTo:
+			err = b.preloadBelongsTo(ctx, owners, r, nested[name])
+		case relationManyToMany:
+			err = b.preloadManyToMany(ctx, owners, r, nested[name])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// preloadHasMany selects the rows whose foreign key is the primary key of
+// one of owners and appends them to the slice field of their owner.
+func (b *Belvedere) preloadHasMany(ctx context.Context, owners []reflect.Value, r *relation, options []NewSelectOption) error {
+	ti := newTableInfo(owners[0].Addr().Interface())
+	if ti.Pk.Name == "" {
+		return ErrNoPrimaryKey
+	}
+
+	fkIndex, err := columnToFieldIndex(r.target, []string{r.fk})
+	if err != nil {
+		return err
+	}
+
+	fieldType := owners[0].FieldByIndex(r.index).Type()
+	children := reflect.New(fieldType)
+	keys := uniqueValues(owners, []int{ti.Pk.Index})
+	if len(keys) > 0 {
+		options = append([]NewSelectOption{IN(r.fk, keys...)}, options...)
+		if err := b.Select(ctx, children.Interface(), options...); err != nil {
+			return err
+		}
+	}
+
+	groups := map[string]reflect.Value{}
+	for i := 0; i < children.Elem().Len(); i++ {
+		child := children.Elem().Index(i)
+		key, ok := keyOf(reflect.Indirect(child).FieldByIndex(fkIndex[0]))
+		if !ok {
+			continue
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			group = reflect.MakeSlice(fieldType, 0, 0)
+		}
+		groups[key] = reflect.Append(group, child)
+	}
+
+	for _, owner := range owners {
+		key, _ := keyOf(owner.Field(ti.Pk.Index))
+		group, ok := groups[key]
+		if !ok {
+			group = reflect.MakeSlice(fieldType, 0, 0)
+		}
+		owner.FieldByIndex(r.index).Set(group)
+	}
+
+	return nil
+}
+
+// preloadBelongsTo selects the rows whose primary key is the foreign key of
+// one of owners and stores them in the field of their owner.
+func (b *Belvedere) preloadBelongsTo(ctx context.Context, owners []reflect.Value, r *relation, options []NewSelectOption) error {
+	ti := newTableInfo(reflect.New(r.target).Interface())
+	if ti.Pk.Name == "" {
+		return ErrNoPrimaryKey
+	}
+
+	fkIndex, err := columnToFieldIndex(owners[0].Type(), []string{r.fk})
+	if err != nil {
+		return err
+	}
+
+	targets := reflect.New(reflect.SliceOf(reflect.PtrTo(r.target)))
+	values := uniqueValues(owners, fkIndex[0])
+	if len(values) > 0 {
+		options = append([]NewSelectOption{IN(ti.Pk.Name, values...)}, options...)
+		if err := b.Select(ctx, targets.Interface(), options...); err != nil {
+			return err
+		}
+	}
+
+	byKey := map[string]reflect.Value{}
+	for i := 0; i < targets.Elem().Len(); i++ {
+		target := targets.Elem().Index(i)
+		if key, ok := keyOf(target.Elem().Field(ti.Pk.Index)); ok {
+			byKey[key] = target
+		}
+	}
+
+	for _, owner := range owners {
+		key, ok := keyOf(owner.FieldByIndex(fkIndex[0]))
+		if !ok {
+			continue
+		}
+
+		target, ok := byKey[key]
+		if !ok {
+			continue
+		}
+
+		f := owner.FieldByIndex(r.index)
+		if f.Kind() == reflect.Ptr {
+			f.Set(target)
+		} else {
+			f.Set(target.Elem())
+		}
+	}
+
+	return nil
+}
+
+// joinRows selects the pairs of owner and target keys of the join table of r
+// whose owner key is one of keys.
+func (b *Belvedere) joinRows(ctx context.Context, r *relation, keys []interface{}) ([][2]interface{}, error) {
+	selectList := strings.Join(quoteAll(b.dialect, []string{r.fk, r.ref}), ", ")
+	q, params, err := b.buildQuery(selectList, b.from(r.join, ""), b.selectOptionMap(IN(r.fk, keys...)))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs [][2]interface{}
+	for rows.Next() {
+		var pair [2]interface{}
+		if err := rows.Scan(&pair[0], &pair[1]); err != nil {
+			return nil, err
+		}
+		for i, value := range pair {
+			if raw, ok := value.([]byte); ok {
+				pair[i] = string(raw)
+			}
+		}
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, rows.Err()
+}
+
+// preloadManyToMany selects the rows linked to owners through the join table
+// of r and stores them in the slice field of their owner.
+func (b *Belvedere) preloadManyToMany(ctx context.Context, owners []reflect.Value, r *relation, options []NewSelectOption) error {
+	ti := newTableInfo(owners[0].Addr().Interface())
+	targetInfo := newTableInfo(reflect.New(r.target).Interface())
+	if ti.Pk.Name == "" || targetInfo.Pk.Name == "" {
+		return ErrNoPrimaryKey
+	}
+
+	fieldType := owners[0].FieldByIndex(r.index).Type()
+	targets := reflect.New(fieldType)
+	links := map[string][]string{}
+	keys := uniqueValues(owners, []int{ti.Pk.Index})
+	if len(keys) > 0 {
+		pairs, err := b.joinRows(ctx, r, keys)
+		if err != nil {
+			return err
+		}
+
+		seen := map[string]bool{}
+		var values []interface{}
+		for _, pair := range pairs {
+			if pair[0] == nil || pair[1] == nil {
+				continue
+			}
+			owner, target := fmt.Sprint(pair[0]), fmt.Sprint(pair[1])
+			links[owner] = append(links[owner], target)
+			if !seen[target] {
+				seen[target] = true
+				values = append(values, pair[1])
+			}
+		}
+
+		if len(values) > 0 {
+			options = append([]NewSelectOption{IN(targetInfo.Pk.Name, values...)}, options...)
+			if err := b.Select(ctx, targets.Interface(), options...); err != nil {
+				return err
+			}
+		}
+	}
+
+	byKey := map[string]reflect.Value{}
+	for i := 0; i < targets.Elem().Len(); i++ {
+		target := targets.Elem().Index(i)
+		if key, ok := keyOf(reflect.Indirect(target).Field(targetInfo.Pk.Index)); ok {
+			byKey[key] = target
+		}
+	}
+
+	for _, owner := range owners {
+		group := reflect.MakeSlice(fieldType, 0, 0)
+		key, _ := keyOf(owner.Field(ti.Pk.Index))
+		for _, targetKey := range links[key] {
+			if target, ok := byKey[targetKey]; ok {
+				group = reflect.Append(group, target)
+			}
+		}
+		owner.FieldByIndex(r.index).Set(group)
+	}
+
+	return nil
+}
+
+// Preload loads the association field name after the rows are selected,
+// with one IN query per association. Nested associations are separated by
+// dots, e.g. `Posts.Comments`.
+func Preload(name string) NewSelectOption {
+	return func() SelectOption {
+		return &preload{
+			path: name,
+		}
+	}
+}
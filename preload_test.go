@@ -0,0 +1,140 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+type (
+	Blogger struct {
+		ID      uint64 `pk:"true"`
+		Name    string
+		Entries []*Entry `rel:"has_many,fk=writer_id"`
+		Tags    []Tag    `rel:"many_to_many,join=blogger_tags"`
+		Badges  []Tag    `rel:"many_to_many,join=badges,fk=owner_id,ref=badge_id"`
+	}
+
+	Tag struct {
+		ID   uint64 `pk:"true"`
+		Name string
+	}
+
+	Entry struct {
+		ID        uint64 `pk:"true"`
+		BloggerID uint64
+		Title     string
+		Blogger   *Blogger `rel:"belongs_to"`
+	}
+)
+
+func TestParseRelation(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        reflect.Type
+		field    string
+		kind     string
+		fk       string
+		join     string
+		ref      string
+		target   reflect.Type
+		hasError bool
+	}{
+		{
+			name:   "has many with foreign key",
+			t:      reflect.TypeOf(Blogger{}),
+			field:  "Entries",
+			kind:   relationHasMany,
+			fk:     "writer_id",
+			target: reflect.TypeOf(Entry{}),
+		},
+		{
+			name:   "belongs to with default foreign key",
+			t:      reflect.TypeOf(Entry{}),
+			field:  "Blogger",
+			kind:   relationBelongsTo,
+			fk:     "blogger_id",
+			target: reflect.TypeOf(Blogger{}),
+		},
+		{
+			name:   "many to many with default columns",
+			t:      reflect.TypeOf(Blogger{}),
+			field:  "Tags",
+			kind:   relationManyToMany,
+			fk:     "blogger_id",
+			join:   "blogger_tags",
+			ref:    "tag_id",
+			target: reflect.TypeOf(Tag{}),
+		},
+		{
+			name:   "many to many with columns",
+			t:      reflect.TypeOf(Blogger{}),
+			field:  "Badges",
+			kind:   relationManyToMany,
+			fk:     "owner_id",
+			join:   "badges",
+			ref:    "badge_id",
+			target: reflect.TypeOf(Tag{}),
+		},
+		{
+			name:     "not a relation",
+			t:        reflect.TypeOf(Entry{}),
+			field:    "Title",
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, e := parseRelation(tt.t, tt.field)
+			if tt.hasError {
+				if e == nil {
+					t.Errorf("parseRelation() should fail")
+				}
+				return
+			}
+			if e != nil {
+				t.Fatal(e)
+			}
+			if r.kind != tt.kind || r.fk != tt.fk || r.target != tt.target {
+				t.Errorf("parseRelation() result: %s %s %v expected value: %s %s %v", r.kind, r.fk, r.target, tt.kind, tt.fk, tt.target)
+			}
+			if r.join != tt.join || r.ref != tt.ref {
+				t.Errorf("parseRelation() join table: %s %s expected value: %s %s", r.join, r.ref, tt.join, tt.ref)
+			}
+		})
+	}
+}
+
+func TestTableInfo_ColumnNames_SkipRelation(t *testing.T) {
+	ti := newTableInfo(&Entry{Title: "foo", Blogger: &Blogger{}})
+	want := "id,blogger_id,title"
+	if cnames := ti.ColumnNames(false); cnames != want {
+		t.Errorf("The column names is not the value you expected expected: %s current value: %s", want, cnames)
+	}
+	if s := ti.StatementString(true); s != "?,?" {
+		t.Errorf("The statement string is not the value you expected expected: %s current value: %s", "?,?", s)
+	}
+
+	values, e := ti.Values(true)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(values, []interface{}{uint64(0), "foo"}) {
+		t.Errorf("The column values is not the value you expected expected: %v current value: %v", []interface{}{uint64(0), "foo"}, values)
+	}
+}
+
+func TestTableInfo_Values_Unexported(t *testing.T) {
+	type Counter struct {
+		Name  string
+		count int
+	}
+
+	values, e := newTableInfo(&Counter{Name: "x", count: 1}).Values(false)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(values, []interface{}{"x", int64(1)}) {
+		t.Errorf("The column values is not the value you expected expected: %v current value: %v", []interface{}{"x", int64(1)}, values)
+	}
+}
@@ -0,0 +1,98 @@
+package belvedere
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+)
+
+// rawQuery prepends the With options among args to query and binds the
+// named parameters of query, if any.
+func (b *Belvedere) rawQuery(query string, args []interface{}) (string, []interface{}, error) {
+	options, args, err := splitWithArgs(args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if arg, ok := namedArg(query, args); ok {
+		if query, args, err = bindNamed(query, arg); err != nil {
+			return "", nil, err
+		}
+	}
+
+	withClause, withParams, err := buildWithClause(b.selectOptionMap(options...).Withs())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return withClause + query, append(withParams, args...), nil
+}
+
+// Raw runs an arbitrary query and appends every row to the slice of structs
+// dst points to. Columns are mapped to fields the same way as in Select.
+// Named parameters are bound as in Where, and With options among args are
+// prepended to query.
+func (b *Belvedere) Raw(ctx context.Context, dst interface{}, query string, args ...interface{}) error {
+	if _, _, err := sliceElemType(dst); err != nil {
+		return err
+	}
+
+	query, args, err := b.rawQuery(query, args)
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	return scanRows(rows, dst)
+}
+
+// RawOne runs an arbitrary query and scans its first row into the struct dst
+// points to. It returns sql.ErrNoRows when the query returns no rows.
+func (b *Belvedere) RawOne(ctx context.Context, dst interface{}, query string, args ...interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("belvedere: cannot scan into a non-pointer struct: %v", reflect.TypeOf(dst))
+	}
+
+	query, args, err := b.rawQuery(query, args)
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	return scanRow(rows, v.Elem())
+}
+
+// scanRow scans the first row of rows into the struct v. It returns
+// sql.ErrNoRows when there are no rows.
+func scanRow(rows *sql.Rows, v reflect.Value) error {
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	colToFieldIndex, err := columnToFieldIndex(v.Type(), cols)
+	if err != nil {
+		return err
+	}
+
+	return rows.Scan(fieldAddrs(v, colToFieldIndex)...)
+}
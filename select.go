@@ -1,7 +1,6 @@
 package belvedere
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,6 +9,7 @@ import (
 type (
 	SelectOptionType string
 	OrderType        int
+	NullsOrder       int
 	SelectOption     interface {
 		Conditions() (string, error)
 		Type() SelectOptionType
@@ -24,6 +24,7 @@ type (
 	where struct {
 		conditions string
 		args       []interface{}
+		err        error
 	}
 
 	limit struct {
@@ -32,13 +33,22 @@ type (
 	}
 
 	whereIn struct {
+		dialectHolder
 		conditions string
 		args       []interface{}
+		negate     bool
 	}
 
 	order struct {
+		dialectHolder
 		conditions string
 		oType      OrderType
+		nulls      NullsOrder
+	}
+
+	orderRaw struct {
+		conditions string
+		args       []interface{}
 	}
 
 	offset struct {
@@ -46,26 +56,58 @@ type (
 	}
 
 	groupBy struct {
-		fieldName string
+		dialectHolder
+		columns []string
 	}
 
-	and struct {
+	having struct {
+		conditions string
+		args       []interface{}
+	}
+
+	distinct struct{}
+
+	selectExpr struct {
+		conditions string
+		args       []interface{}
+		err        error
+	}
+
+	// whereGroup holds the children of a composite condition.
+	whereGroup struct {
+		dialectHolder
 		newWheres []NewSelectOption
-		som       SelectOptionMap
+		wheres    []SelectOption
+	}
+
+	and struct {
+		whereGroup
 	}
 
 	or struct {
-		newWheres []NewSelectOption
-		som       SelectOptionMap
+		whereGroup
+	}
+
+	not struct {
+		whereGroup
 	}
 )
 
 var (
-	selectOptionTypeWhere   = SelectOptionType("where")
-	selectOptionTypeLimit   = SelectOptionType("limit")
-	selectOptionTypeOrder   = SelectOptionType("order")
-	selectOptionTypeGroupBy = SelectOptionType("group by")
-	selectOptionTypeOffset  = SelectOptionType("offset")
+	selectOptionTypeWhere    = SelectOptionType("where")
+	selectOptionTypeLimit    = SelectOptionType("limit")
+	selectOptionTypeOrder    = SelectOptionType("order")
+	selectOptionTypeGroupBy  = SelectOptionType("group by")
+	selectOptionTypeOffset   = SelectOptionType("offset")
+	selectOptionTypeHaving   = SelectOptionType("having")
+	selectOptionTypePaginate = SelectOptionType("paginate")
+	selectOptionTypeJoin     = SelectOptionType("join")
+	selectOptionTypePreload  = SelectOptionType("preload")
+	selectOptionTypeColumns  = SelectOptionType("columns")
+	selectOptionTypeWith     = SelectOptionType("with")
+	selectOptionTypeDistinct = SelectOptionType("distinct")
+	selectOptionTypeExpr     = SelectOptionType("select expr")
+	selectOptionTypeLock     = SelectOptionType("lock")
 )
 
 const (
@@ -73,6 +115,12 @@ const (
 	OrderTypeAsc
 )
 
+const (
+	NullsDefault = NullsOrder(iota)
+	NullsFirst
+	NullsLast
+)
+
 func (o OrderType) String() string {
 	if o == OrderTypeDesc {
 		return "DESC"
@@ -105,22 +153,123 @@ func (som SelectOptionMap) Wheres() []SelectOption {
 	return nil
 }
 
-func (som SelectOptionMap) Order() SelectOption {
+func (som SelectOptionMap) Orders() []SelectOption {
 	if value, ok := som[selectOptionTypeOrder]; ok {
-		return value[0]
+		return value
 	}
 
 	return nil
 }
 
-func (som SelectOptionMap) GroupBy() SelectOption {
+func (som SelectOptionMap) GroupBy() []SelectOption {
 	if value, ok := som[selectOptionTypeGroupBy]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Paginate() SelectOption {
+	if value, ok := som[selectOptionTypePaginate]; ok {
+		return value[0]
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Joins() []SelectOption {
+	if value, ok := som[selectOptionTypeJoin]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Preloads() []SelectOption {
+	if value, ok := som[selectOptionTypePreload]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Columns() []SelectOption {
+	if value, ok := som[selectOptionTypeColumns]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Withs() []SelectOption {
+	if value, ok := som[selectOptionTypeWith]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Distinct() SelectOption {
+	if value, ok := som[selectOptionTypeDistinct]; ok {
 		return value[0]
 	}
 
 	return nil
 }
 
+func (som SelectOptionMap) SelectExprs() []SelectOption {
+	if value, ok := som[selectOptionTypeExpr]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Locks() []SelectOption {
+	if value, ok := som[selectOptionTypeLock]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) Havings() []SelectOption {
+	if value, ok := som[selectOptionTypeHaving]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func (som SelectOptionMap) setDialect(d Dialect) {
+	for _, options := range som {
+		for _, option := range options {
+			if o, ok := option.(dialectOption); ok {
+				o.setDialect(d)
+			}
+		}
+	}
+}
+
+// only returns ErrUnsupportedOption if som contains an option that is not
+// one of types.
+func (som SelectOptionMap) only(types ...SelectOptionType) error {
+	for key := range som {
+		supported := false
+		for _, t := range types {
+			if key.Equal(t) {
+				supported = true
+			}
+		}
+
+		if !supported {
+			return ErrUnsupportedOption
+		}
+	}
+
+	return nil
+}
+
 func (st SelectOptionType) Equal(t SelectOptionType) bool {
 	return t.String() == st.String()
 }
@@ -131,7 +280,7 @@ func (st SelectOptionType) String() string {
 
 // where
 func (w *where) Conditions() (string, error) {
-	return w.conditions, nil
+	return w.conditions, w.err
 }
 
 func (w *where) Params() []interface{} {
@@ -156,18 +305,35 @@ func (l *limit) Type() SelectOptionType {
 
 // in
 func (wi *whereIn) Conditions() (string, error) {
-	length := len(wi.Params())
-	qms := make([]string, length)
-	for i := 0; i < length; i++ {
-		qms[i] = "?"
+	if len(wi.args) == 0 {
+		// `IN ()` is invalid SQL; an empty list matches nothing.
+		if wi.negate {
+			return "1 = 1", nil
+		}
+		return "1 = 0", nil
+	}
+
+	qms, _, err := bindArgs(wi.getDialect(), wi.args)
+	if err != nil {
+		return "", err
+	}
+
+	operator := "IN"
+	if wi.negate {
+		operator = "NOT IN"
 	}
 
-	phs := strings.Join(qms, ", ")
-	return fmt.Sprintf("%s IN (%s)", wi.conditions, phs), nil
+	// A lone sub-select is the list itself.
+	if _, ok := wi.args[0].(subSelect); ok && len(wi.args) == 1 {
+		return fmt.Sprintf("%s %s %s", wi.quote(wi.conditions), operator, qms[0]), nil
+	}
+
+	return fmt.Sprintf("%s %s (%s)", wi.quote(wi.conditions), operator, strings.Join(qms, ", ")), nil
 }
 
 func (wi *whereIn) Params() []interface{} {
-	return wi.args
+	_, params, _ := bindArgs(wi.getDialect(), wi.args)
+	return params
 }
 
 func (wi *whereIn) Type() SelectOptionType {
@@ -176,7 +342,12 @@ func (wi *whereIn) Type() SelectOptionType {
 
 // order
 func (o *order) Conditions() (string, error) {
-	return fmt.Sprintf(" ORDER BY `%s` %s", o.conditions, o.oType.String()), nil
+	if err := validateIdentifier(o.conditions); err != nil {
+		return "", err
+	}
+
+	d := o.getDialect()
+	return d.SortKey(d.Quote(o.conditions), o.oType, o.nulls), nil
 }
 
 func (o *order) Params() []interface{} {
@@ -187,6 +358,19 @@ func (o *order) Type() SelectOptionType {
 	return selectOptionTypeOrder
 }
 
+// raw order
+func (o *orderRaw) Conditions() (string, error) {
+	return o.conditions, nil
+}
+
+func (o *orderRaw) Params() []interface{} {
+	return o.args
+}
+
+func (o *orderRaw) Type() SelectOptionType {
+	return selectOptionTypeOrder
+}
+
 // offset
 func (o *offset) Conditions() (string, error) {
 	return " OFFSET ?", nil
@@ -204,167 +388,228 @@ func (o *offset) Type() SelectOptionType {
 
 // group by
 func (g *groupBy) Conditions() (string, error) {
-	return " GROUP BY ?", nil
+	if len(g.columns) == 0 {
+		return "", errors.New("GroupBy requires at least one column")
+	}
+
+	quoted := make([]string, len(g.columns))
+	for i, column := range g.columns {
+		if err := validateIdentifier(column); err != nil {
+			return "", err
+		}
+		quoted[i] = g.quote(column)
+	}
+
+	return strings.Join(quoted, ", "), nil
 }
 
 func (g *groupBy) Params() []interface{} {
-	return []interface{}{
-		g.fieldName,
-	}
+	return []interface{}{}
 }
 
 func (g *groupBy) Type() SelectOptionType {
 	return selectOptionTypeGroupBy
 }
 
-// and
-func (a *and) Conditions() (string, error) {
-	var buf bytes.Buffer
-	length := len(a.newWheres)
-	if length == 0 {
-		return "", nil
-	}
+// having
+func (h *having) Conditions() (string, error) {
+	return h.conditions, nil
+}
 
-	som := a.newSelectOptionMap()
+func (h *having) Params() []interface{} {
+	return h.args
+}
 
-	for i, w := range som.Wheres() {
-		if w.Type() != selectOptionTypeWhere {
-			return "", ErrDifferentOptionType
-		}
-		c, err := w.Conditions()
-		if err != nil {
-			return "", err
-		}
+func (h *having) Type() SelectOptionType {
+	return selectOptionTypeHaving
+}
 
-		buf.WriteString(c)
-		if i < length-1 {
-			buf.WriteString(" AND ")
-		}
-	}
+// distinct
+func (d *distinct) Conditions() (string, error) {
+	return "DISTINCT ", nil
+}
 
-	return buf.String(), nil
+func (d *distinct) Params() []interface{} {
+	return []interface{}{}
 }
 
-func (a *and) newSelectOptionMap() SelectOptionMap {
-	if len(a.som) > 0 {
-		return a.som
+func (d *distinct) Type() SelectOptionType {
+	return selectOptionTypeDistinct
+}
+
+// select expr
+func (s *selectExpr) Conditions() (string, error) {
+	return s.conditions, s.err
+}
+
+func (s *selectExpr) Params() []interface{} {
+	return s.args
+}
+
+func (s *selectExpr) Type() SelectOptionType {
+	return selectOptionTypeExpr
+}
+
+// where group
+func (g *whereGroup) options() []SelectOption {
+	if g.wheres != nil {
+		return g.wheres
 	}
 
-	som := newSelectOptionMap(a.newWheres...)
-	a.som = som
+	wheres := make([]SelectOption, len(g.newWheres))
+	for i, newWhere := range g.newWheres {
+		w := newWhere()
+		if o, ok := w.(dialectOption); ok && g.dialect != nil {
+			o.setDialect(g.dialect)
+		}
+		wheres[i] = w
+	}
+	g.wheres = wheres
 
-	return som
+	return wheres
 }
 
-func (a *and) Params() []interface{} {
-	length := len(a.newWheres)
-	if length == 0 {
-		return []interface{}{}
+func (g *whereGroup) setDialect(d Dialect) {
+	g.dialect = d
+	for _, w := range g.options() {
+		if o, ok := w.(dialectOption); ok {
+			o.setDialect(d)
+		}
 	}
+}
 
-	var params []interface{}
+// wrap joins the children with sep and encloses them in parentheses.
+func (g *whereGroup) wrap(prefix, sep string) (string, error) {
+	c, err := joinConditions(g.options(), sep)
+	if err != nil || c == "" {
+		return "", err
+	}
+
+	return prefix + "(" + c + ")", nil
+}
 
-	som := a.newSelectOptionMap()
-	for _, w := range som.Wheres() {
+func (g *whereGroup) Params() []interface{} {
+	params := []interface{}{}
+	for _, w := range g.options() {
 		params = append(params, w.Params()...)
 	}
 
 	return params
 }
 
-func (a *and) Type() SelectOptionType {
+func (g *whereGroup) Type() SelectOptionType {
 	return selectOptionTypeWhere
 }
 
+// and
+func (a *and) Conditions() (string, error) {
+	return a.wrap("", " AND ")
+}
+
 // or
 func (o *or) Conditions() (string, error) {
-	var buf bytes.Buffer
-	length := len(o.newWheres)
-	if length == 0 {
-		return "", nil
+	return o.wrap("", " OR ")
+}
+
+// not
+func (n *not) Conditions() (string, error) {
+	// A single group is already parenthesized.
+	if options := n.options(); len(options) == 1 {
+		switch options[0].(type) {
+		case *and, *or, *not:
+			c, err := options[0].Conditions()
+			if err != nil || c == "" {
+				return "", err
+			}
+			return "NOT " + c, nil
+		}
 	}
 
-	som := o.newSelectOptionMap()
+	return n.wrap("NOT ", " AND ")
+}
 
-	for i, w := range som.Wheres() {
-		if w.Type() != selectOptionTypeWhere {
+// joinConditions joins where options with sep. Raw Where fragments are
+// parenthesized when they have siblings so that an OR inside a fragment
+// cannot leak into the surrounding expression.
+func joinConditions(selectOptions []SelectOption, sep string) (string, error) {
+	conditions := make([]string, 0, len(selectOptions))
+	for _, option := range selectOptions {
+		if !option.Type().Equal(selectOptionTypeWhere) {
 			return "", ErrDifferentOptionType
 		}
-		c, err := w.Conditions()
+
+		c, err := option.Conditions()
 		if err != nil {
 			return "", err
 		}
+		if c == "" {
+			continue
+		}
 
-		buf.WriteString(c)
-		if i < length-1 {
-			buf.WriteString(" OR ")
+		if _, ok := option.(*where); ok && len(selectOptions) > 1 {
+			c = "(" + c + ")"
 		}
+		conditions = append(conditions, c)
 	}
 
-	return buf.String(), nil
+	return strings.Join(conditions, sep), nil
 }
 
-func (o *or) newSelectOptionMap() SelectOptionMap {
-	if len(o.som) > 0 {
-		return o.som
+// buildWhereClause ANDs the given where options together.
+func buildWhereClause(selectOptions []SelectOption) (string, []interface{}, error) {
+	var values []interface{}
+	if len(selectOptions) == 0 {
+		return "", values, nil
 	}
 
-	som := newSelectOptionMap(o.newWheres...)
-	o.som = som
-
-	return som
-}
-
-func (o *or) Params() []interface{} {
-	length := len(o.newWheres)
-	if length == 0 {
-		return []interface{}{}
+	conditions, err := joinConditions(selectOptions, " AND ")
+	if err != nil {
+		return "", values, err
 	}
-
-	var params []interface{}
-
-	som := o.newSelectOptionMap()
-	for _, w := range som.Wheres() {
-		params = append(params, w.Params()...)
+	if conditions == "" {
+		return "", values, nil
 	}
 
-	return params
-}
+	for _, option := range selectOptions {
+		values = append(values, option.Params()...)
+	}
 
-func (o *or) Type() SelectOptionType {
-	return selectOptionTypeWhere
+	return " WHERE " + conditions, values, nil
 }
 
-func buildWhereClause(selectOptions []SelectOption) (string, []interface{}, error) {
-	var buf bytes.Buffer
+func buildOrderClause(selectOptions []SelectOption) (string, []interface{}, error) {
 	var values []interface{}
 	if len(selectOptions) == 0 {
 		return "", values, nil
 	}
 
-	buf.WriteString(" WHERE ")
-	for _, option := range selectOptions {
-		t := option.Type()
-		if t.Equal(selectOptionTypeWhere) {
-			condition, err := option.Conditions()
-			if err != nil {
-				return "", values, err
-			}
-			buf.WriteString(condition)
-			for _, v := range option.Params() {
-				values = append(values, v)
-			}
+	keys := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", values, err
 		}
+		keys[i] = c
+		values = append(values, option.Params()...)
 	}
-	return buf.String(), values, nil
+
+	return " ORDER BY " + strings.Join(keys, ", "), values, nil
 }
 
-func buildOrderClause(o SelectOption) (string, error) {
-	if o == nil {
-		return "", nil
+// buildSelectExprList joins the expressions of the SelectExpr options.
+func buildSelectExprList(selectOptions []SelectOption) (string, []interface{}, error) {
+	var values []interface{}
+	exprs := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", values, err
+		}
+		exprs[i] = c
+		values = append(values, option.Params()...)
 	}
 
-	return o.Conditions()
+	return strings.Join(exprs, ", "), values, nil
 }
 
 func buildOffsetClause(o SelectOption) (string, []interface{}, error) {
@@ -376,13 +621,45 @@ func buildOffsetClause(o SelectOption) (string, []interface{}, error) {
 	return conditions, o.Params(), nil
 }
 
-func buildGroupByClause(o SelectOption) (string, []interface{}) {
-	if o == nil {
-		return "", []interface{}{}
+func buildGroupByClause(selectOptions []SelectOption) (string, error) {
+	if len(selectOptions) == 0 {
+		return "", nil
 	}
 
-	conditions, _ := o.Conditions()
-	return conditions, o.Params()
+	columns := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", err
+		}
+		columns[i] = c
+	}
+
+	return " GROUP BY " + strings.Join(columns, ", "), nil
+}
+
+func buildHavingClause(selectOptions []SelectOption) (string, []interface{}, error) {
+	var values []interface{}
+	if len(selectOptions) == 0 {
+		return "", values, nil
+	}
+
+	conditions := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", values, err
+		}
+		// Parenthesized like raw Where fragments, so that an OR inside
+		// one cannot leak into the others.
+		if len(selectOptions) > 1 {
+			c = "(" + c + ")"
+		}
+		conditions[i] = c
+		values = append(values, option.Params()...)
+	}
+
+	return " HAVING " + strings.Join(conditions, " AND "), values, nil
 }
 
 func buildLimitClause(o SelectOption) (string, []interface{}, error) {
@@ -420,6 +697,24 @@ func newSelectOptionMap(optionFncs ...NewSelectOption) SelectOptionMap {
 			key = selectOptionTypeOffset
 		} else if t == selectOptionTypeGroupBy {
 			key = selectOptionTypeGroupBy
+		} else if t == selectOptionTypeHaving {
+			key = selectOptionTypeHaving
+		} else if t == selectOptionTypePaginate {
+			key = selectOptionTypePaginate
+		} else if t == selectOptionTypeJoin {
+			key = selectOptionTypeJoin
+		} else if t == selectOptionTypePreload {
+			key = selectOptionTypePreload
+		} else if t == selectOptionTypeColumns {
+			key = selectOptionTypeColumns
+		} else if t == selectOptionTypeWith {
+			key = selectOptionTypeWith
+		} else if t == selectOptionTypeDistinct {
+			key = selectOptionTypeDistinct
+		} else if t == selectOptionTypeExpr {
+			key = selectOptionTypeExpr
+		} else if t == selectOptionTypeLock {
+			key = selectOptionTypeLock
 		}
 		som[key] = append(som[key], option)
 	}
@@ -427,12 +722,21 @@ func newSelectOptionMap(optionFncs ...NewSelectOption) SelectOptionMap {
 	return som
 }
 
+// Where adds a raw condition. Besides positional `?` placeholders, the
+// condition may use `:name` or `@name` placeholders when the only argument
+// is a map[string]interface{} or a struct.
 func Where(conditions string, args ...interface{}) NewSelectOption {
 	return func() SelectOption {
-		return &where{
+		w := &where{
 			conditions: conditions,
 			args:       args,
 		}
+
+		if arg, ok := namedArg(conditions, args); ok {
+			w.conditions, w.args, w.err = bindNamed(conditions, arg)
+		}
+
+		return w
 	}
 }
 
@@ -447,6 +751,8 @@ func Limit(p int) NewSelectOption {
 	}
 }
 
+// IN `column IN (args...)`. A single *SubQuery argument renders
+// `column IN (SELECT ...)`.
 func IN(conditions string, args ...interface{}) NewSelectOption {
 	return func() SelectOption {
 		return &whereIn{
@@ -456,6 +762,19 @@ func IN(conditions string, args ...interface{}) NewSelectOption {
 	}
 }
 
+// NotIn `column NOT IN (args...)`
+func NotIn(conditions string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &whereIn{
+			conditions: conditions,
+			args:       args,
+			negate:     true,
+		}
+	}
+}
+
+// Order sorts by a column. Multiple Order options are applied in the order
+// they are given.
 func Order(field string, oType OrderType) NewSelectOption {
 	return func() SelectOption {
 		return &order{
@@ -465,6 +784,29 @@ func Order(field string, oType OrderType) NewSelectOption {
 	}
 }
 
+// OrderNulls sorts by a column and places NULL values first or last.
+func OrderNulls(field string, oType OrderType, nulls NullsOrder) NewSelectOption {
+	return func() SelectOption {
+		return &order{
+			conditions: field,
+			oType:      oType,
+			nulls:      nulls,
+		}
+	}
+}
+
+// OrderRaw sorts by an arbitrary expression such as `FIELD(status, ?, ?)`.
+// The expression is not quoted, so it must not contain user input other
+// than through args.
+func OrderRaw(expr string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &orderRaw{
+			conditions: expr,
+			args:       args,
+		}
+	}
+}
+
 func Offset(amount uint) NewSelectOption {
 	return func() SelectOption {
 		return &offset{
@@ -473,18 +815,53 @@ func Offset(amount uint) NewSelectOption {
 	}
 }
 
-func GroupBy(fn string) NewSelectOption {
+func GroupBy(columns ...string) NewSelectOption {
 	return func() SelectOption {
 		return &groupBy{
-			fieldName: fn,
+			columns: columns,
 		}
 	}
 }
 
+func Having(conditions string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &having{
+			conditions: conditions,
+			args:       args,
+		}
+	}
+}
+
+// Distinct removes duplicate rows from the result.
+func Distinct() NewSelectOption {
+	return func() SelectOption {
+		return &distinct{}
+	}
+}
+
+// SelectExpr adds a computed column such as `COUNT(post.id) AS post_count`
+// to the select list. Its value is scanned into the field named after the
+// alias, which is tagged `readonly:"true"` so that Insert and Update skip
+// it. Named parameters are bound as in Where.
+func SelectExpr(expr string, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		s := &selectExpr{
+			conditions: expr,
+			args:       args,
+		}
+
+		if arg, ok := namedArg(expr, args); ok {
+			s.conditions, s.args, s.err = bindNamed(expr, arg)
+		}
+
+		return s
+	}
+}
+
 func And(neWheres ...NewSelectOption) NewSelectOption {
 	return func() SelectOption {
 		return &and{
-			newWheres: neWheres,
+			whereGroup{newWheres: neWheres},
 		}
 	}
 }
@@ -492,7 +869,16 @@ func And(neWheres ...NewSelectOption) NewSelectOption {
 func Or(neWheres ...NewSelectOption) NewSelectOption {
 	return func() SelectOption {
 		return &or{
-			newWheres: neWheres,
+			whereGroup{newWheres: neWheres},
+		}
+	}
+}
+
+// Not negates its conditions, which are ANDed together.
+func Not(neWheres ...NewSelectOption) NewSelectOption {
+	return func() SelectOption {
+		return &not{
+			whereGroup{newWheres: neWheres},
 		}
 	}
 }
@@ -20,7 +20,7 @@ func TestWhereIn_Conditions(t *testing.T) {
 				1,
 				2,
 			},
-			want: "id IN (?, ?)",
+			want: "`id` IN (?, ?)",
 			err:  nil,
 		},
 	}
@@ -54,7 +54,7 @@ func TestAnd_Conditions(t *testing.T) {
 				Where("gender = ?", 'f'),
 			},
 
-			want: "age = ? AND gender = ?",
+			want: "((age = ?) AND (gender = ?))",
 			err:  nil,
 		},
 		{
@@ -65,7 +65,7 @@ func TestAnd_Conditions(t *testing.T) {
 				IN("id", 1, 2, 3),
 			},
 
-			want: "age = ? AND gender = ? AND id IN (?, ?, ?)",
+			want: "((age = ?) AND (gender = ?) AND `id` IN (?, ?, ?))",
 			err:  nil,
 		},
 	}
@@ -133,3 +133,352 @@ func TestAnd_Params(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_Conditions(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		oType OrderType
+		want  string
+		err   error
+	}{
+		{
+			name:  "order by reserved word",
+			field: "order",
+			oType: OrderTypeAsc,
+			want:  "`order` ASC",
+			err:   nil,
+		},
+		{
+			name:  "order by qualified column",
+			field: "user.created_at",
+			oType: OrderTypeDesc,
+			want:  "`user`.`created_at` DESC",
+			err:   nil,
+		},
+		{
+			name:  "reject expression",
+			field: "id; DROP TABLE user",
+			oType: OrderTypeAsc,
+			want:  "",
+			err:   ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Order(tt.field, tt.oType)()
+			q, e := o.Conditions()
+			if q != tt.want {
+				t.Errorf("order.Conditions() result: %s expected value: %s", q, tt.want)
+			}
+			if e != tt.err {
+				t.Errorf("order.Conditions() err: %s expected value: %s", e, tt.err)
+			}
+		})
+	}
+}
+
+func TestBuildGroupByClause(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []NewSelectOption
+		want    string
+		err     error
+	}{
+		{
+			name:    "single column",
+			options: []NewSelectOption{GroupBy("gendor")},
+			want:    " GROUP BY `gendor`",
+			err:     nil,
+		},
+		{
+			name:    "multiple columns",
+			options: []NewSelectOption{GroupBy("gendor", "age"), GroupBy("group")},
+			want:    " GROUP BY `gendor`, `age`, `group`",
+			err:     nil,
+		},
+		{
+			name:    "reject expression",
+			options: []NewSelectOption{GroupBy("1; DROP TABLE user")},
+			want:    "",
+			err:     ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			som := newSelectOptionMap(tt.options...)
+			q, e := buildGroupByClause(som.GroupBy())
+			if q != tt.want {
+				t.Errorf("buildGroupByClause() result: %s expected value: %s", q, tt.want)
+			}
+			if e != tt.err {
+				t.Errorf("buildGroupByClause() err: %s expected value: %s", e, tt.err)
+			}
+		})
+	}
+}
+
+func TestBuildHavingClause(t *testing.T) {
+	som := newSelectOptionMap(
+		Having("COUNT(*) > ?", 1),
+		Having("MAX(age) < ?", 30),
+	)
+	q, p, e := buildHavingClause(som.Havings())
+	want := " HAVING (COUNT(*) > ?) AND (MAX(age) < ?)"
+	if q != want {
+		t.Errorf("buildHavingClause() result: %s expected value: %s", q, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{1, 30}) {
+		t.Errorf("buildHavingClause() params: %v expected value: %v", p, []interface{}{1, 30})
+	}
+	if e != nil {
+		t.Errorf("buildHavingClause() err: %s", e)
+	}
+}
+
+func TestBuildHavingClause_Or(t *testing.T) {
+	som := newSelectOptionMap(
+		Having("COUNT(*) > ? OR MAX(age) > ?", 1, 60),
+		Having("MIN(age) > ?", 20),
+	)
+	q, _, e := buildHavingClause(som.Havings())
+	want := " HAVING (COUNT(*) > ? OR MAX(age) > ?) AND (MIN(age) > ?)"
+	if q != want {
+		t.Errorf("buildHavingClause() result: %s expected value: %s", q, want)
+	}
+	if e != nil {
+		t.Errorf("buildHavingClause() err: %s", e)
+	}
+
+	som = newSelectOptionMap(Having("COUNT(*) > ?", 1))
+	q, _, _ = buildHavingClause(som.Havings())
+	if q != " HAVING COUNT(*) > ?" {
+		t.Errorf("buildHavingClause() result: %s expected value: %s", q, " HAVING COUNT(*) > ?")
+	}
+}
+
+func TestBuildOrderClause(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []NewSelectOption
+		want    string
+		params  []interface{}
+	}{
+		{
+			name:    "multiple sort keys",
+			options: []NewSelectOption{Order("age", OrderTypeDesc), Order("id", OrderTypeAsc)},
+			want:    " ORDER BY `age` DESC, `id` ASC",
+			params:  nil,
+		},
+		{
+			name:    "nulls first",
+			options: []NewSelectOption{OrderNulls("deleted_at", OrderTypeAsc, NullsFirst)},
+			want:    " ORDER BY `deleted_at` IS NULL DESC, `deleted_at` ASC",
+			params:  nil,
+		},
+		{
+			name:    "raw expression",
+			options: []NewSelectOption{OrderRaw("FIELD(status, ?, ?)", "active", "pending"), Order("id", OrderTypeAsc)},
+			want:    " ORDER BY FIELD(status, ?, ?), `id` ASC",
+			params:  []interface{}{"active", "pending"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			som := newSelectOptionMap(tt.options...)
+			q, p, e := buildOrderClause(som.Orders())
+			if q != tt.want {
+				t.Errorf("buildOrderClause() result: %s expected value: %s", q, tt.want)
+			}
+			if !reflect.DeepEqual(p, tt.params) {
+				t.Errorf("buildOrderClause() params: %v expected value: %v", p, tt.params)
+			}
+			if e != nil {
+				t.Errorf("buildOrderClause() err: %s", e)
+			}
+		})
+	}
+}
+
+func TestBuildWhereClause(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []NewSelectOption
+		want    string
+		params  []interface{}
+		err     error
+	}{
+		{
+			name:    "top level options are ANDed",
+			options: []NewSelectOption{Where("age > ?", 20), Where("gendor = ?", "male")},
+			want:    " WHERE (age > ?) AND (gendor = ?)",
+			params:  []interface{}{20, "male"},
+			err:     nil,
+		},
+		{
+			name:    "single option is not parenthesized",
+			options: []NewSelectOption{Where("age > ?", 20)},
+			want:    " WHERE age > ?",
+			params:  []interface{}{20},
+			err:     nil,
+		},
+		{
+			name: "or nested in and",
+			options: []NewSelectOption{
+				And(Or(Where("a = ?", 1), Where("b = ?", 2)), Where("c = ?", 3)),
+			},
+			want:   " WHERE (((a = ?) OR (b = ?)) AND (c = ?))",
+			params: []interface{}{1, 2, 3},
+			err:    nil,
+		},
+		{
+			name: "and nested in or",
+			options: []NewSelectOption{
+				Or(And(IN("id", 1, 2), Where("a = ?", 1)), IN("id", 3)),
+			},
+			want:   " WHERE ((`id` IN (?, ?) AND (a = ?)) OR `id` IN (?))",
+			params: []interface{}{1, 2, 1, 3},
+			err:    nil,
+		},
+		{
+			name: "not",
+			options: []NewSelectOption{
+				Where("age > ?", 20),
+				Not(Or(Where("a = ?", 1), Where("b = ?", 2))),
+			},
+			want:   " WHERE (age > ?) AND NOT ((a = ?) OR (b = ?))",
+			params: []interface{}{20, 1, 2},
+			err:    nil,
+		},
+		{
+			name: "not of several conditions",
+			options: []NewSelectOption{
+				Not(Where("a = ?", 1), Or(Where("b = ?", 2), Where("c = ?", 3))),
+			},
+			want:   " WHERE NOT ((a = ?) AND ((b = ?) OR (c = ?)))",
+			params: []interface{}{1, 2, 3},
+			err:    nil,
+		},
+		{
+			name:    "empty group",
+			options: []NewSelectOption{And()},
+			want:    "",
+			params:  nil,
+			err:     nil,
+		},
+		{
+			name:    "reject non-where option",
+			options: []NewSelectOption{And(Where("a = ?", 1), Limit(1))},
+			want:    "",
+			params:  nil,
+			err:     ErrDifferentOptionType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			som := newSelectOptionMap(tt.options...)
+			q, p, e := buildWhereClause(som.Wheres())
+			if q != tt.want {
+				t.Errorf("buildWhereClause() result: %s expected value: %s", q, tt.want)
+			}
+			if !reflect.DeepEqual(p, tt.params) {
+				t.Errorf("buildWhereClause() params: %v expected value: %v", p, tt.params)
+			}
+			if e != tt.err {
+				t.Errorf("buildWhereClause() err: %s expected value: %s", e, tt.err)
+			}
+		})
+	}
+}
+
+func TestSelectOptionMap_Only(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []NewSelectOption
+		err     error
+	}{
+		{
+			name:    "only where options",
+			options: []NewSelectOption{Where("age > ?", 20), Eq("gendor", "male")},
+			err:     nil,
+		},
+		{
+			name:    "limit is not supported",
+			options: []NewSelectOption{Where("age > ?", 20), Limit(1)},
+			err:     ErrUnsupportedOption,
+		},
+		{
+			name:    "group by is not supported",
+			options: []NewSelectOption{GroupBy("gendor")},
+			err:     ErrUnsupportedOption,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newSelectOptionMap(tt.options...).only(selectOptionTypeWhere)
+			if e != tt.err {
+				t.Errorf("SelectOptionMap.only() err: %v expected value: %v", e, tt.err)
+			}
+		})
+	}
+}
+
+func TestBuildSelectQuery_Expr(t *testing.T) {
+	b := &Belvedere{dialect: mysqlDialect{}}
+	tests := []struct {
+		name    string
+		t       reflect.Type
+		options []NewSelectOption
+		want    string
+		params  []interface{}
+	}{
+		{
+			name:    "distinct",
+			t:       reflect.TypeOf(User{}),
+			options: []NewSelectOption{Distinct(), Columns("name")},
+			want:    "SELECT DISTINCT `name` FROM `user`",
+		},
+		{
+			name: "select expr",
+			t:    reflect.TypeOf(User{}),
+			options: []NewSelectOption{
+				Eq("user.name", "foo"),
+				LeftJoin("post", "p", "p.user_id = user.id AND p.title <> ?", ""),
+				SelectExpr("COUNT(p.id) > ? AS active", 3),
+				SelectExpr("COUNT(p.id) AS post_count"),
+				GroupBy("user.id"),
+			},
+			want:   "SELECT `user`.*, COUNT(p.id) > ? AS active, COUNT(p.id) AS post_count FROM `user` LEFT JOIN `post` AS `p` ON p.user_id = user.id AND p.title <> ? WHERE `user`.`name` = ? GROUP BY `user`.`id`",
+			params: []interface{}{3, "", "foo"},
+		},
+		{
+			name:    "distinct select expr without join",
+			t:       reflect.TypeOf(User{}),
+			options: []NewSelectOption{Distinct(), SelectExpr("LENGTH(name) AS name_length"), Limit(1)},
+			want:    "SELECT DISTINCT `user`.*, LENGTH(name) AS name_length FROM `user` LIMIT ?",
+			params:  []interface{}{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, p, e := b.buildSelectQuery(tt.t, b.selectOptionMap(tt.options...))
+			if e != nil {
+				t.Fatal(e)
+			}
+			if q != tt.want {
+				t.Errorf("buildSelectQuery() result: %s expected value: %s", q, tt.want)
+			}
+			if len(p) != 0 || len(tt.params) != 0 {
+				if !reflect.DeepEqual(p, tt.params) {
+					t.Errorf("buildSelectQuery() params: %v expected value: %v", p, tt.params)
+				}
+			}
+		})
+	}
+}
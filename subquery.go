@@ -0,0 +1,189 @@
+package belvedere
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// SubQuery is a SELECT built from options, used as the operand of IN,
+	// Exists and the comparison helpers.
+	SubQuery struct {
+		t       reflect.Type
+		options []NewSelectOption
+	}
+
+	columns struct {
+		dialectHolder
+		columns []string
+	}
+
+	// subSelect is implemented by *SubQuery and *UnionQuery.
+	subSelect interface {
+		build(d Dialect) (string, []interface{}, error)
+	}
+
+	exists struct {
+		dialectHolder
+		sub    subSelect
+		negate bool
+	}
+)
+
+// build renders the SELECT of s with the identifiers quoted by d.
+func (s *SubQuery) build(d Dialect) (string, []interface{}, error) {
+	if s.t.Kind() != reflect.Struct {
+		return "", nil, fmt.Errorf("belvedere: cannot select from a non-struct model: %v", s.t)
+	}
+
+	b := &Belvedere{dialect: d}
+	som := b.selectOptionMap(s.options...)
+	err := som.only(
+		selectOptionTypeColumns,
+		selectOptionTypeDistinct,
+		selectOptionTypeExpr,
+		selectOptionTypeJoin,
+		selectOptionTypeWhere,
+		selectOptionTypeGroupBy,
+		selectOptionTypeHaving,
+		selectOptionTypeOrder,
+		selectOptionTypeLimit,
+		selectOptionTypeOffset,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return b.buildSelectQuery(s.t, som)
+}
+
+// bounded reports whether s has its own ORDER BY, LIMIT or OFFSET.
+func (s *SubQuery) bounded() bool {
+	som := newSelectOptionMap(s.options...)
+	return len(som.Orders()) > 0 || som.Limit() != nil || som.Offset() != nil
+}
+
+// bindArgs returns a placeholder for each of args. A *SubQuery or
+// *UnionQuery is rendered in parentheses and its parameters take its place.
+func bindArgs(d Dialect, args []interface{}) ([]string, []interface{}, error) {
+	placeholders := make([]string, len(args))
+	var params []interface{}
+	for i, arg := range args {
+		sub, ok := arg.(subSelect)
+		if !ok {
+			placeholders[i] = "?"
+			params = append(params, arg)
+			continue
+		}
+
+		q, subParams, err := sub.build(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		placeholders[i] = "(" + q + ")"
+		params = append(params, subParams...)
+	}
+
+	return placeholders, params, nil
+}
+
+// columns
+func (c *columns) Conditions() (string, error) {
+	if len(c.columns) == 0 {
+		return "", fmt.Errorf("belvedere: Columns requires at least one column")
+	}
+
+	quoted := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		if err := validateIdentifier(column); err != nil {
+			return "", err
+		}
+		quoted[i] = c.quote(column)
+	}
+
+	return strings.Join(quoted, ", "), nil
+}
+
+func (c *columns) Params() []interface{} {
+	return []interface{}{}
+}
+
+func (c *columns) Type() SelectOptionType {
+	return selectOptionTypeColumns
+}
+
+// exists
+func (e *exists) Conditions() (string, error) {
+	q, _, err := e.sub.build(e.getDialect())
+	if err != nil {
+		return "", err
+	}
+
+	if e.negate {
+		return "NOT EXISTS (" + q + ")", nil
+	}
+
+	return "EXISTS (" + q + ")", nil
+}
+
+func (e *exists) Params() []interface{} {
+	_, params, _ := e.sub.build(e.getDialect())
+	return params
+}
+
+func (e *exists) Type() SelectOptionType {
+	return selectOptionTypeWhere
+}
+
+// buildColumnList joins the columns of the Columns options.
+func buildColumnList(selectOptions []SelectOption) (string, error) {
+	lists := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", err
+		}
+		lists[i] = c
+	}
+
+	return strings.Join(lists, ", "), nil
+}
+
+// Sub builds a sub-select of the table of model, e.g.
+//
+//	IN("id", Sub(&Post{}, Columns("user_id"), Where("published = ?", 1)))
+func Sub(model interface{}, options ...NewSelectOption) *SubQuery {
+	return &SubQuery{
+		t:       reflect.Indirect(reflect.ValueOf(model)).Type(),
+		options: options,
+	}
+}
+
+// Columns selects only the given columns instead of `*`.
+func Columns(names ...string) NewSelectOption {
+	return func() SelectOption {
+		return &columns{
+			columns: names,
+		}
+	}
+}
+
+// Exists `EXISTS (sub)`
+func Exists(sub *SubQuery) NewSelectOption {
+	return func() SelectOption {
+		return &exists{
+			sub: sub,
+		}
+	}
+}
+
+// NotExists `NOT EXISTS (sub)`
+func NotExists(sub *SubQuery) NewSelectOption {
+	return func() SelectOption {
+		return &exists{
+			sub:    sub,
+			negate: true,
+		}
+	}
+}
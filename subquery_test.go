@@ -0,0 +1,94 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubQuery_Conditions(t *testing.T) {
+	published := Sub(&Post{}, Columns("user_id"), Where("title LIKE ?", "a%"), Limit(10))
+	tests := []struct {
+		name       string
+		option     NewSelectOption
+		conditions string
+		params     []interface{}
+	}{
+		{
+			name:       "in",
+			option:     IN("id", published),
+			conditions: "`id` IN (SELECT `user_id` FROM `post` WHERE title LIKE ? LIMIT ?)",
+			params:     []interface{}{"a%", 10},
+		},
+		{
+			name:       "not in",
+			option:     NotIn("id", Sub(&Post{}, Columns("user_id"))),
+			conditions: "`id` NOT IN (SELECT `user_id` FROM `post`)",
+		},
+		{
+			name:       "comparison",
+			option:     Gt("age", Sub(&User{}, Columns("id"), Eq("name", "foo"))),
+			conditions: "`age` > (SELECT `id` FROM `user` WHERE `name` = ?)",
+			params:     []interface{}{"foo"},
+		},
+		{
+			name:       "between",
+			option:     Between("id", 1, Sub(&Post{}, Columns("user_id"), Order("id", OrderTypeDesc), Limit(1))),
+			conditions: "`id` BETWEEN ? AND (SELECT `user_id` FROM `post` ORDER BY `id` DESC LIMIT ?)",
+			params:     []interface{}{1, 1},
+		},
+		{
+			name:       "exists",
+			option:     Exists(Sub(&Post{}, Where("post.user_id = user.id"), Eq("title", "foo"))),
+			conditions: "EXISTS (SELECT * FROM `post` WHERE (post.user_id = user.id) AND `title` = ?)",
+			params:     []interface{}{"foo"},
+		},
+		{
+			name:       "not exists",
+			option:     NotExists(Sub(&Post{}, Where("post.user_id = user.id"))),
+			conditions: "NOT EXISTS (SELECT * FROM `post` WHERE post.user_id = user.id)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.option()
+			c, e := o.Conditions()
+			if e != nil {
+				t.Fatal(e)
+			}
+			if c != tt.conditions {
+				t.Errorf("Conditions() result: %s expected value: %s", c, tt.conditions)
+			}
+			if !reflect.DeepEqual(o.Params(), tt.params) {
+				t.Errorf("Params() result: %v expected value: %v", o.Params(), tt.params)
+			}
+		})
+	}
+}
+
+func TestSubQuery_ParamsOrder(t *testing.T) {
+	som := newSelectOptionMap(
+		Eq("name", "foo"),
+		IN("id", Sub(&Post{}, Columns("user_id"), Eq("title", "bar"))),
+		Gt("age", 20),
+	)
+	c, p, e := buildWhereClause(som.Wheres())
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	want := " WHERE `name` = ? AND `id` IN (SELECT `user_id` FROM `post` WHERE `title` = ?) AND `age` > ?"
+	if c != want {
+		t.Errorf("buildWhereClause() result: %s expected value: %s", c, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{"foo", "bar", 20}) {
+		t.Errorf("buildWhereClause() params: %v expected value: %v", p, []interface{}{"foo", "bar", 20})
+	}
+}
+
+func TestSubQuery_UnsupportedOption(t *testing.T) {
+	o := IN("id", Sub(&Post{}, Preload("User")))()
+	if _, e := o.Conditions(); e != ErrUnsupportedOption {
+		t.Errorf("Conditions() err: %v expected value: %v", e, ErrUnsupportedOption)
+	}
+}
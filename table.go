@@ -1,10 +1,11 @@
 package belvedere
 
 import (
-	"bytes"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 )
 
@@ -29,50 +30,12 @@ func (p pk) SameName(name string) bool {
 func (p pk) SameIndex(index int) bool {
 	return index == p.Index
 }
-func fieldPts(t reflect.Type, v reflect.Value) ([]interface{}, error) {
-	var pts []interface{}
-	for i := 0; i < t.NumField(); i++ {
-		f := v.Field(i)
-		// TODO: JSON Type
-		if f.IsValid() {
-			switch f.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				value := f.Int()
-				pts = append(pts, &value)
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-				value := f.Uint()
-				pts = append(pts, &value)
-			case reflect.Float32, reflect.Float64:
-				value := f.Float()
-				pts = append(pts, &value)
-			case reflect.String:
-				value := f.String()
-				pts = append(pts, &value)
-			case reflect.Bool:
-				var value int
-				pts = append(pts, &value)
-			case reflect.Struct:
-				i := f.Interface()
-				if f.Type().String() == "time.Time" {
-					if value, ok := i.(time.Time); ok {
-						pts = append(pts, &value)
-					} else {
-						return nil, errors.New("cannot convert this type")
-					}
-				} else {
-					return nil, errors.New("cannot convert this type")
-				}
-			default:
-				fmt.Println(f.Kind())
-			}
-		}
-	}
 
-	return pts, nil
-}
-
-func (ti *tableInfo) FieldPts() ([]interface{}, error) {
-	return fieldPts(ti.ColumnInfo, ti.ColumnValue)
+// isColumnField reports whether f is stored in a column of the table.
+// Association fields are filled by Preload instead, and read-only fields
+// receive computed columns.
+func isColumnField(f reflect.StructField) bool {
+	return f.Tag.Get("rel") == "" && f.Tag.Get("readonly") != "true"
 }
 
 func (ti *tableInfo) PkValue() (interface{}, error) {
@@ -116,6 +79,17 @@ func (ti *tableInfo) Values(excludePk bool) ([]interface{}, error) {
 		if excludePk && ti.Pk.SameIndex(i) {
 			continue
 		}
+		if !isColumnField(ti.ColumnInfo.Field(i)) {
+			continue
+		}
+		// Nullable types such as sql.NullInt64 convert themselves.
+		// Unexported fields cannot be converted to an interface.
+		if f.CanInterface() {
+			if valuer, ok := f.Interface().(driver.Valuer); ok {
+				values = append(values, valuer)
+				continue
+			}
+		}
 		// TODO: JSON Type
 		if f.IsValid() {
 			switch f.Kind() {
@@ -155,10 +129,7 @@ func (ti *tableInfo) Values(excludePk bool) ([]interface{}, error) {
 }
 
 func (ti *tableInfo) StatementString(excludePk bool) string {
-	valuesNum := ti.ColumnValue.NumField()
-	if excludePk {
-		valuesNum = valuesNum - 1
-	}
+	valuesNum := len(ti.ColumnNameList(excludePk))
 
 	var buf []byte
 	for i := 0; i < valuesNum; i++ {
@@ -171,39 +142,29 @@ func (ti *tableInfo) StatementString(excludePk bool) string {
 	return string(buf)
 }
 
-func (ti *tableInfo) SetValue(values []interface{}) {
+// ColumnNameList Retrieve column names.
+func (ti *tableInfo) ColumnNameList(excludePk bool) []string {
+	var names []string
 	for i := 0; i < ti.ColumnInfo.NumField(); i++ {
 		f := ti.ColumnInfo.Field(i)
-		fv := ti.ColumnValue.Field(i)
-		v := values[i]
-		rv := reflect.ValueOf(v).Elem()
-		if !f.Anonymous {
-			fv.Set(rv)
+		if !isColumnField(f) {
+			continue
 		}
-	}
-}
-
-// ColumnNames Retrieve comma-separated column names.
-func (ti *tableInfo) ColumnNames(excludePk bool) string {
-	var buf bytes.Buffer
-	var columnNum int
 
-	columnNum = ti.ColumnInfo.NumField()
-	for i := 0; i < columnNum; i++ {
-		columnName := camelToSnake(ti.ColumnInfo.Field(i).Name)
+		columnName := camelToSnake(f.Name)
 		if excludePk && ti.Pk.SameName(columnName) {
 			continue
 		}
 
-		isLast := i == ti.ColumnInfo.NumField()-1
-		if isLast {
-			buf.WriteString(columnName)
-		} else {
-			buf.WriteString(columnName + ",")
-		}
+		names = append(names, columnName)
 	}
 
-	return buf.String()
+	return names
+}
+
+// ColumnNames Retrieve comma-separated column names.
+func (ti *tableInfo) ColumnNames(excludePk bool) string {
+	return strings.Join(ti.ColumnNameList(excludePk), ",")
 }
 
 // generateInsertQuery Generate an insert statement from the structure.
@@ -0,0 +1,59 @@
+package belvedere
+
+import (
+	"context"
+	"database/sql"
+)
+
+type (
+	// conn is implemented by *sql.DB and *sql.Tx.
+	conn interface {
+		ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+		PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+		QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	}
+
+	txKey struct{}
+)
+
+func txFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok
+}
+
+// conn returns the transaction of ctx, or the database outside of one.
+func (b *Belvedere) conn(ctx context.Context) conn {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+
+	return b.db
+}
+
+// Transaction runs fn in a transaction. Every query run with the context
+// passed to fn joins it. The transaction is committed when fn returns nil and
+// rolled back otherwise. Inside a transaction, fn joins the outer one.
+func (b *Belvedere) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := txFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
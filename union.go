@@ -0,0 +1,118 @@
+package belvedere
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// UnionQuery combines the rows of several sub-selects with the same columns.
+type UnionQuery struct {
+	subs []*SubQuery
+	all  bool
+}
+
+// build renders the sub-selects of u, each in parentheses so that they may
+// have their own ORDER BY and LIMIT.
+func (u *UnionQuery) build(d Dialect) (string, []interface{}, error) {
+	return u.render(d, false)
+}
+
+// render renders the sub-selects of u. When minimal is set, only those with
+// their own ORDER BY, LIMIT or OFFSET are enclosed in parentheses, which
+// keeps the body of a recursive common table expression portable.
+func (u *UnionQuery) render(d Dialect, minimal bool) (string, []interface{}, error) {
+	if len(u.subs) < 2 {
+		return "", nil, errors.New("belvedere: a union requires at least two queries")
+	}
+
+	operator := " UNION "
+	if u.all {
+		operator = " UNION ALL "
+	}
+
+	queries := make([]string, len(u.subs))
+	var params []interface{}
+	for i, sub := range u.subs {
+		q, subParams, err := sub.build(d)
+		if err != nil {
+			return "", nil, err
+		}
+		if !minimal || sub.bounded() {
+			q = "(" + q + ")"
+		}
+		queries[i] = q
+		params = append(params, subParams...)
+	}
+
+	return strings.Join(queries, operator), params, nil
+}
+
+// buildUnionQuery appends the order, limit and offset options of som, which
+// apply to the combined rows, to u.
+func (b *Belvedere) buildUnionQuery(u *UnionQuery, som SelectOptionMap) (string, []interface{}, error) {
+	err := som.only(selectOptionTypeOrder, selectOptionTypeLimit, selectOptionTypeOffset)
+	if err != nil {
+		return "", nil, err
+	}
+
+	q, params, err := u.build(b.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	orderClause, orderParams, err := buildOrderClause(som.Orders())
+	if err != nil {
+		return "", nil, err
+	}
+
+	limitClause, limitParams, err := buildLimitClause(som.Limit())
+	if err != nil {
+		return "", nil, err
+	}
+
+	offsetClause, offsetParams, _ := buildOffsetClause(som.Offset())
+
+	q = q + orderClause + limitClause + offsetClause
+	params = append(params, orderParams...)
+	params = append(params, limitParams...)
+	params = append(params, offsetParams...)
+
+	return q, params, nil
+}
+
+// Union combines the distinct rows of subs.
+func Union(subs ...*SubQuery) *UnionQuery {
+	return &UnionQuery{
+		subs: subs,
+	}
+}
+
+// UnionAll combines every row of subs, keeping duplicates.
+func UnionAll(subs ...*SubQuery) *UnionQuery {
+	return &UnionQuery{
+		subs: subs,
+		all:  true,
+	}
+}
+
+// SelectUnion runs u and appends every row to the slice dst points to.
+// Order, Limit and Offset options apply to the combined rows.
+func (b *Belvedere) SelectUnion(ctx context.Context, dst interface{}, u *UnionQuery, options ...NewSelectOption) error {
+	if _, _, err := sliceElemType(dst); err != nil {
+		return err
+	}
+
+	q, params, err := b.buildUnionQuery(u, b.selectOptionMap(options...))
+	if err != nil {
+		return err
+	}
+
+	rows, err := b.query(ctx, q, params)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	return scanRows(rows, dst)
+}
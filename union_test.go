@@ -0,0 +1,73 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildUnionQuery(t *testing.T) {
+	b := &Belvedere{dialect: mysqlDialect{}}
+	tests := []struct {
+		name    string
+		u       *UnionQuery
+		options []NewSelectOption
+		want    string
+		params  []interface{}
+		err     error
+	}{
+		{
+			name: "union",
+			u:    Union(Sub(&User{}, Eq("name", "foo")), Sub(&User{}, Gt("id", 10))),
+			options: []NewSelectOption{
+				Order("id", OrderTypeDesc),
+				Limit(20),
+				Offset(40),
+			},
+			want:   "(SELECT * FROM `user` WHERE `name` = ?) UNION (SELECT * FROM `user` WHERE `id` > ?) ORDER BY `id` DESC LIMIT ? OFFSET ?",
+			params: []interface{}{"foo", 10, 20, uint(40)},
+		},
+		{
+			name:   "union all",
+			u:      UnionAll(Sub(&Post{}, Columns("id", "title")), Sub(&Post{}, Columns("id", "title"), Limit(1))),
+			want:   "(SELECT `id`, `title` FROM `post`) UNION ALL (SELECT `id`, `title` FROM `post` LIMIT ?)",
+			params: []interface{}{1},
+		},
+		{
+			name:    "where is not supported",
+			u:       Union(Sub(&User{}), Sub(&User{})),
+			options: []NewSelectOption{Eq("id", 1)},
+			err:     ErrUnsupportedOption,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, p, e := b.buildUnionQuery(tt.u, b.selectOptionMap(tt.options...))
+			if e != tt.err {
+				t.Fatalf("buildUnionQuery() err: %v expected value: %v", e, tt.err)
+			}
+			if q != tt.want {
+				t.Errorf("buildUnionQuery() result: %s expected value: %s", q, tt.want)
+			}
+			if e == nil && !reflect.DeepEqual(p, tt.params) {
+				t.Errorf("buildUnionQuery() params: %v expected value: %v", p, tt.params)
+			}
+		})
+	}
+}
+
+func TestUnionQuery_In(t *testing.T) {
+	o := IN("id", Union(Sub(&Post{}, Columns("user_id")), Sub(&User{}, Columns("id"), Eq("name", "foo"))))()
+	c, e := o.Conditions()
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	want := "`id` IN ((SELECT `user_id` FROM `post`) UNION (SELECT `id` FROM `user` WHERE `name` = ?))"
+	if c != want {
+		t.Errorf("Conditions() result: %s expected value: %s", c, want)
+	}
+	if !reflect.DeepEqual(o.Params(), []interface{}{"foo"}) {
+		t.Errorf("Params() result: %v expected value: %v", o.Params(), []interface{}{"foo"})
+	}
+}
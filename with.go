@@ -0,0 +1,129 @@
+package belvedere
+
+import (
+	"fmt"
+	"strings"
+)
+
+// with is a named common table expression.
+type with struct {
+	dialectHolder
+	name      string
+	source    interface{}
+	args      []interface{}
+	recursive bool
+}
+
+// build renders the query of the expression.
+func (w *with) build() (string, []interface{}, error) {
+	switch source := w.source.(type) {
+	case *UnionQuery:
+		return source.render(w.getDialect(), true)
+	case subSelect:
+		return source.build(w.getDialect())
+	case string:
+		if arg, ok := namedArg(source, w.args); ok {
+			return bindNamed(source, arg)
+		}
+		return source, w.args, nil
+	default:
+		return "", nil, fmt.Errorf("belvedere: unsupported source of %s: %T", w.name, w.source)
+	}
+}
+
+func (w *with) Conditions() (string, error) {
+	if err := validateIdentifier(w.name); err != nil {
+		return "", err
+	}
+
+	q, _, err := w.build()
+	if err != nil {
+		return "", err
+	}
+
+	return w.quote(w.name) + " AS (" + q + ")", nil
+}
+
+func (w *with) Params() []interface{} {
+	_, params, _ := w.build()
+	return params
+}
+
+func (w *with) Type() SelectOptionType {
+	return selectOptionTypeWith
+}
+
+// buildWithClause renders the WITH clause preceding a SELECT. It is
+// RECURSIVE if any of the expressions is.
+func buildWithClause(selectOptions []SelectOption) (string, []interface{}, error) {
+	var values []interface{}
+	if len(selectOptions) == 0 {
+		return "", values, nil
+	}
+
+	recursive := false
+	expressions := make([]string, len(selectOptions))
+	for i, option := range selectOptions {
+		c, err := option.Conditions()
+		if err != nil {
+			return "", values, err
+		}
+		expressions[i] = c
+		values = append(values, option.Params()...)
+
+		if w, ok := option.(*with); ok && w.recursive {
+			recursive = true
+		}
+	}
+
+	if recursive {
+		return "WITH RECURSIVE " + strings.Join(expressions, ", ") + " ", values, nil
+	}
+
+	return "WITH " + strings.Join(expressions, ", ") + " ", values, nil
+}
+
+// splitWithArgs separates the With options passed among the arguments of a
+// raw query from its parameters.
+func splitWithArgs(args []interface{}) ([]NewSelectOption, []interface{}, error) {
+	var options []NewSelectOption
+	var params []interface{}
+	for _, arg := range args {
+		option, ok := arg.(NewSelectOption)
+		if !ok {
+			params = append(params, arg)
+			continue
+		}
+		if option().Type() != selectOptionTypeWith {
+			return nil, nil, ErrUnsupportedOption
+		}
+		options = append(options, option)
+	}
+
+	return options, params, nil
+}
+
+// With names the result of source, a *SubQuery, a *UnionQuery or a raw query
+// with args, so that the query can refer to it as a table.
+func With(name string, source interface{}, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &with{
+			name:   name,
+			source: source,
+			args:   args,
+		}
+	}
+}
+
+// WithRecursive is With for an expression that refers to itself, typically a
+// UnionAll of a base query and a query joining name.
+func WithRecursive(name string, source interface{}, args ...interface{}) NewSelectOption {
+	return func() SelectOption {
+		return &with{
+			name:      name,
+			source:    source,
+			args:      args,
+			recursive: true,
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package belvedere
+
+import (
+	"reflect"
+	"testing"
+)
+
+type Category struct {
+	ID       uint64 `pk:"true"`
+	ParentID uint64
+	Name     string
+}
+
+func TestBuildSelectQuery_With(t *testing.T) {
+	b := &Belvedere{dialect: mysqlDialect{}}
+	tree := UnionAll(
+		Sub(&Category{}, Eq("id", 1)),
+		Sub(&Category{}, InnerJoin("tree", "t", "t.id = category.parent_id")),
+	)
+	tests := []struct {
+		name    string
+		t       reflect.Type
+		options []NewSelectOption
+		want    string
+		params  []interface{}
+		err     error
+	}{
+		{
+			name: "with sub query",
+			t:    reflect.TypeOf(User{}),
+			options: []NewSelectOption{
+				Eq("name", "foo"),
+				InnerJoin("adult", "a", "a.id = user.id"),
+				With("adult", Sub(&User{}, Columns("id"), Gte("age", 20))),
+			},
+			want:   "WITH `adult` AS (SELECT `id` FROM `user` WHERE `age` >= ?) SELECT `user`.* FROM `user` INNER JOIN `adult` AS `a` ON a.id = user.id WHERE `name` = ?",
+			params: []interface{}{20, "foo"},
+		},
+		{
+			name: "with recursive union",
+			t:    reflect.TypeOf(Category{}),
+			options: []NewSelectOption{
+				WithRecursive("tree", tree),
+				InnerJoin("tree", "", "tree.id = category.id"),
+			},
+			want:   "WITH RECURSIVE `tree` AS (SELECT * FROM `category` WHERE `id` = ? UNION ALL SELECT `category`.* FROM `category` INNER JOIN `tree` AS `t` ON t.id = category.parent_id) SELECT `category`.* FROM `category` INNER JOIN `tree` ON tree.id = category.id",
+			params: []interface{}{1},
+		},
+		{
+			name: "with raw queries",
+			t:    reflect.TypeOf(Category{}),
+			options: []NewSelectOption{
+				With("a", "SELECT id FROM category WHERE parent_id = ?", 1),
+				WithRecursive("b", "SELECT id FROM category WHERE name = :name", map[string]interface{}{"name": "foo"}),
+				Limit(10),
+			},
+			want:   "WITH RECURSIVE `a` AS (SELECT id FROM category WHERE parent_id = ?), `b` AS (SELECT id FROM category WHERE name = ?) SELECT * FROM `category` LIMIT ?",
+			params: []interface{}{1, "foo", 10},
+		},
+		{
+			name:    "invalid name",
+			t:       reflect.TypeOf(Category{}),
+			options: []NewSelectOption{With("a b", "SELECT 1")},
+			err:     ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, p, e := b.buildSelectQuery(tt.t, b.selectOptionMap(tt.options...))
+			if e != tt.err {
+				t.Fatalf("buildSelectQuery() err: %v expected value: %v", e, tt.err)
+			}
+			if q != tt.want {
+				t.Errorf("buildSelectQuery() result: %s expected value: %s", q, tt.want)
+			}
+			if e == nil && !reflect.DeepEqual(p, tt.params) {
+				t.Errorf("buildSelectQuery() params: %v expected value: %v", p, tt.params)
+			}
+		})
+	}
+}
+
+func TestRawQuery_With(t *testing.T) {
+	b := &Belvedere{dialect: mysqlDialect{}}
+	q, p, e := b.rawQuery(
+		"SELECT * FROM tree WHERE parent_id > ?",
+		[]interface{}{WithRecursive("tree", "SELECT * FROM category WHERE id = ?", 1), 5},
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	want := "WITH RECURSIVE `tree` AS (SELECT * FROM category WHERE id = ?) SELECT * FROM tree WHERE parent_id > ?"
+	if q != want {
+		t.Errorf("rawQuery() result: %s expected value: %s", q, want)
+	}
+	if !reflect.DeepEqual(p, []interface{}{1, 5}) {
+		t.Errorf("rawQuery() params: %v expected value: %v", p, []interface{}{1, 5})
+	}
+
+	if _, _, e := b.rawQuery("SELECT 1", []interface{}{Limit(1)}); e != ErrUnsupportedOption {
+		t.Errorf("rawQuery() err: %v expected value: %v", e, ErrUnsupportedOption)
+	}
+}